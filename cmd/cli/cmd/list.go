@@ -1,19 +1,24 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
 
 	"github.com/bvwells/grpc-gateway-example/proto/beers"
 
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 )
 
 const address = "localhost:50000"
 
-var page int32 = 1
+var (
+	pageSize   int32
+	pageToken  string
+	brewer     string
+	country    string
+	namePrefix string
+	orderBy    string
+)
 
 // getCmd represents the get command
 var getCmd = &cobra.Command{
@@ -29,7 +34,7 @@ var getCmd = &cobra.Command{
 		}
 
 		// Set up a connection to the server.
-		conn, err := grpc.Dial(address, grpc.WithInsecure())
+		conn, err := dial()
 		if err != nil {
 			fmt.Println("unable to connect to beer grpc server")
 			os.Exit(1)
@@ -38,9 +43,14 @@ var getCmd = &cobra.Command{
 
 		c := beers.NewBeerServiceClient(conn)
 
-		ctx := context.Background()
+		ctx := newContext()
 		resp, err := c.ListBeers(ctx, &beers.ListBeersRequest{
-			Page: page,
+			PageSize:   pageSize,
+			PageToken:  pageToken,
+			Brewer:     brewer,
+			Country:    country,
+			NamePrefix: namePrefix,
+			OrderBy:    orderBy,
 		})
 		if err != nil {
 			return
@@ -49,11 +59,19 @@ var getCmd = &cobra.Command{
 		for _, beer := range resp.Beers {
 			fmt.Println(beer)
 		}
+		if resp.NextPageToken != "" {
+			fmt.Printf("next page token: %s\n", resp.NextPageToken)
+		}
 	},
 }
 
 func init() {
-	getCmd.Flags().Int32VarP(&page, "page", "p", 1, "page number")
+	getCmd.Flags().Int32Var(&pageSize, "page-size", 0, "maximum number of beers to list")
+	getCmd.Flags().StringVar(&pageToken, "page-token", "", "page token returned by a previous list")
+	getCmd.Flags().StringVar(&brewer, "brewer", "", "only list beers from brewer")
+	getCmd.Flags().StringVar(&country, "country", "", "only list beers from country")
+	getCmd.Flags().StringVar(&namePrefix, "name-prefix", "", "only list beers whose name starts with prefix")
+	getCmd.Flags().StringVar(&orderBy, "order-by", "", "order by clause, e.g. \"name desc,brewer\"")
 
 	rootCmd.AddCommand(getCmd)
 }
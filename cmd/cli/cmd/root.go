@@ -1,13 +1,20 @@
 package cmd
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"os"
 
 	"github.com/spf13/cobra"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 )
 
 var cfgFile string
@@ -36,6 +43,75 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.cli.yaml)")
+	rootCmd.PersistentFlags().String("token", "", "bearer token to authenticate with")
+	if err := viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("token")); err != nil {
+		panic(err)
+	}
+	rootCmd.PersistentFlags().String("api-key", "", "API key to authenticate with")
+	if err := viper.BindPFlag("api_key", rootCmd.PersistentFlags().Lookup("api-key")); err != nil {
+		panic(err)
+	}
+	rootCmd.PersistentFlags().Bool("tls", false, "connect to the beers grpc server with TLS")
+	rootCmd.PersistentFlags().String("ca-file", "", "file containing the CAs the server certificate is verified with, the system CAs if empty")
+	rootCmd.PersistentFlags().String("cert-file", "", "file containing the client certificate to present to the server")
+	rootCmd.PersistentFlags().String("key-file", "", "file containing the private key of the client certificate")
+	for key, flag := range map[string]string{
+		"tls":       "tls",
+		"ca_file":   "ca-file",
+		"cert_file": "cert-file",
+		"key_file":  "key-file",
+	} {
+		if err := viper.BindPFlag(key, rootCmd.PersistentFlags().Lookup(flag)); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// dial connects to the beers grpc server, with TLS if the tls flag, TLS
+// environment variable or tls in the config file is set, or a CA file or
+// client certificate is specified.
+func dial() (*grpc.ClientConn, error) {
+	caFile := viper.GetString("ca_file")
+	certFile := viper.GetString("cert_file")
+	keyFile := viper.GetString("key_file")
+	if !viper.GetBool("tls") && caFile == "" && certFile == "" {
+		return grpc.Dial(address, grpc.WithInsecure())
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile) // #nosec G304 file is provided by the user.
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in '%s'", caFile)
+		}
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+}
+
+// newContext returns a new context for calling the beers grpc server, carrying
+// the bearer token from the token flag, TOKEN environment variable or config
+// file, and the API key from the api-key flag, API_KEY environment variable or
+// api_key in the config file, if set.
+func newContext() context.Context {
+	ctx := context.Background()
+	if token := viper.GetString("token"); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+	if apiKey := viper.GetString("api_key"); apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", apiKey)
+	}
+	return ctx
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -0,0 +1,486 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Config describes the configuration of the gateway.
+type Config struct {
+	GRPC            GRPCConfig      `mapstructure:"grpc"`
+	HTTP            HTTPConfig      `mapstructure:"http"`
+	TLS             TLSConfig       `mapstructure:"tls"`
+	Repository      string          `mapstructure:"repository"`
+	Postgres        PostgresConfig  `mapstructure:"postgres"`
+	PageToken       PageTokenConfig `mapstructure:"page_token"`
+	Health          HealthConfig    `mapstructure:"health"`
+	Purge           PurgeConfig     `mapstructure:"purge"`
+	Watch           WatchConfig     `mapstructure:"watch"`
+	Batch           BatchConfig     `mapstructure:"batch"`
+	Tracing         TracingConfig   `mapstructure:"tracing"`
+	Auth            AuthConfig      `mapstructure:"auth"`
+	RateLimit       RateLimitConfig `mapstructure:"rate_limit"`
+	ShutdownTimeout time.Duration   `mapstructure:"shutdown_timeout"`
+}
+
+// GRPCConfig describes the configuration of the gRPC server.
+type GRPCConfig struct {
+	Address string `mapstructure:"address"`
+}
+
+// HTTPConfig describes the configuration of the HTTP gateway.
+type HTTPConfig struct {
+	Address string `mapstructure:"address"`
+}
+
+// TLSConfig describes the configuration of TLS for the gRPC server and HTTP
+// gateway.
+type TLSConfig struct {
+	CertFile       string        `mapstructure:"cert_file"`
+	KeyFile        string        `mapstructure:"key_file"`
+	ClientCAFile   string        `mapstructure:"client_ca_file"`
+	CAFile         string        `mapstructure:"ca_file"`
+	ServerName     string        `mapstructure:"server_name"`
+	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+}
+
+// Enabled returns true if the gRPC server and HTTP gateway serve TLS.
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// PostgresConfig describes the configuration of the postgres database.
+type PostgresConfig struct {
+	Host         string `mapstructure:"host"`
+	Port         int    `mapstructure:"port"`
+	User         string `mapstructure:"user"`
+	Password     string `mapstructure:"password"`
+	PasswordFile string `mapstructure:"password_file"`
+	DBName       string `mapstructure:"dbname"`
+	SSLMode      string `mapstructure:"sslmode"`
+	SSLRootCert  string `mapstructure:"sslrootcert"`
+	// StatementTimeout is the time after which statements are canceled.
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+	MaxOpenConns     int           `mapstructure:"max_open_conns"`
+	MaxIdleConns     int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime  time.Duration `mapstructure:"conn_max_lifetime"`
+	// AutoMigrate migrates the schema when the gateway starts.
+	AutoMigrate bool `mapstructure:"auto_migrate"`
+}
+
+// PageTokenConfig describes the configuration for signing page tokens.
+type PageTokenConfig struct {
+	Secret     string `mapstructure:"secret"`
+	SecretFile string `mapstructure:"secret_file"`
+}
+
+// HealthConfig describes the configuration of health checks.
+type HealthConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+// PurgeConfig describes the configuration of purging deleted beers.
+type PurgeConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Retention is the time deleted beers are kept for before they are
+	// purged.
+	Retention time.Duration `mapstructure:"retention"`
+	Interval  time.Duration `mapstructure:"interval"`
+}
+
+// WatchConfig describes the configuration of watching the events of beers.
+type WatchConfig struct {
+	// History is the number of the most recent events kept for resuming
+	// watches.
+	History int `mapstructure:"history"`
+}
+
+// BatchConfig describes the configuration of batch operations on beers.
+type BatchConfig struct {
+	// MaxSize is the largest number of beers in a batch.
+	MaxSize int `mapstructure:"max_size"`
+}
+
+// Validate validates the purge configuration.
+func (c *PurgeConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Retention < 0 {
+		return fmt.Errorf("invalid purge retention %s", c.Retention)
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("invalid purge interval %s", c.Interval)
+	}
+	return nil
+}
+
+// TracingConfig describes the configuration of tracing.
+type TracingConfig struct {
+	Exporter     string  `mapstructure:"exporter"`
+	OTLPEndpoint string  `mapstructure:"otlp_endpoint"`
+	SampleRatio  float64 `mapstructure:"sample_ratio"`
+}
+
+// AuthConfig describes the configuration of authentication and authorization.
+type AuthConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	JWKSFile   string `mapstructure:"jwks_file"`
+	Issuer     string `mapstructure:"issuer"`
+	Audience   string `mapstructure:"audience"`
+	PolicyFile string `mapstructure:"policy_file"`
+}
+
+// RateLimitConfig describes the configuration of rate limiting.
+type RateLimitConfig struct {
+	Enabled bool                    `mapstructure:"enabled"`
+	Rate    float64                 `mapstructure:"rate"`
+	Burst   int                     `mapstructure:"burst"`
+	Methods []MethodRateLimitConfig `mapstructure:"methods"`
+}
+
+// MethodRateLimitConfig describes the rate limit of a method, overriding the
+// default rate limit.
+type MethodRateLimitConfig struct {
+	Method string  `mapstructure:"method"`
+	Rate   float64 `mapstructure:"rate"`
+	Burst  int     `mapstructure:"burst"`
+}
+
+// option describes a configuration option. Options with a usage are also
+// command line flags, the flag name being the key with dots and underscores
+// replaced by dashes.
+type option struct {
+	key   string
+	value interface{}
+	usage string
+}
+
+var options = []option{
+	{key: "grpc.address", value: "127.0.0.1:50000", usage: "address the gRPC server listens on"},
+	{key: "http.address", value: ":8080", usage: "address the HTTP gateway listens on"},
+	{key: "tls.cert_file", value: "", usage: "file containing the certificate the gRPC server and HTTP gateway serve TLS with, TLS is disabled if empty"},
+	{key: "tls.key_file", value: "", usage: "file containing the private key of the TLS certificate"},
+	{key: "tls.client_ca_file", value: "", usage: "file containing the CAs which must sign client certificates of gRPC callers, client certificates are not required if empty"},
+	{key: "tls.ca_file", value: "", usage: "file containing the CAs the HTTP gateway verifies the gRPC server with, the system CAs if empty"},
+	{key: "tls.server_name", value: "", usage: "name the HTTP gateway verifies the gRPC server certificate for, the gRPC address host if empty"},
+	{key: "tls.reload_interval", value: 30 * time.Second, usage: "interval between checks of the TLS certificate files for changes"},
+	{key: "repository", value: "postgres", usage: "beer repository to use, either 'postgres' or 'memory'"},
+	{key: "postgres.host", value: "localhost", usage: "postgres host"},
+	{key: "postgres.port", value: 5432, usage: "postgres port"},
+	{key: "postgres.user", value: "postgres", usage: "postgres user"},
+	{key: "postgres.password", value: ""},
+	{key: "postgres.password_file", value: "", usage: "file containing the postgres password"},
+	{key: "postgres.dbname", value: "beers", usage: "postgres database name"},
+	{key: "postgres.sslmode", value: "disable", usage: "postgres sslmode, either 'disable', 'require', 'verify-ca' or 'verify-full'"},
+	{key: "postgres.sslrootcert", value: "", usage: "file containing the CAs the postgres server certificate is verified with"},
+	{key: "postgres.statement_timeout", value: 5 * time.Second, usage: "time after which postgres statements are canceled, no timeout if 0"},
+	{key: "postgres.max_open_conns", value: 20, usage: "maximum number of open postgres connections, unlimited if 0"},
+	{key: "postgres.max_idle_conns", value: 5, usage: "maximum number of idle postgres connections"},
+	{key: "postgres.conn_max_lifetime", value: 30 * time.Minute, usage: "maximum time a postgres connection is reused, unlimited if 0"},
+	{key: "postgres.auto_migrate", value: false, usage: "apply pending postgres schema migrations on start"},
+	{key: "page_token.secret", value: ""},
+	{key: "page_token.secret_file", value: "", usage: "file containing the secret page tokens are signed with"},
+	{key: "health.interval", value: 10 * time.Second, usage: "interval between health checks of the beer repository"},
+	{key: "health.timeout", value: 2 * time.Second, usage: "timeout of health checks of the beer repository"},
+	{key: "purge.enabled", value: true, usage: "periodically purge deleted beers"},
+	{key: "purge.retention", value: 720 * time.Hour, usage: "time deleted beers are kept for before they are purged"},
+	{key: "purge.interval", value: time.Hour, usage: "interval between purges of deleted beers"},
+	{key: "watch.history", value: 1000, usage: "number of the most recent beer events kept for resuming watches"},
+	{key: "batch.max_size", value: 100, usage: "largest number of beers in a batch get, create or delete"},
+	{key: "tracing.exporter", value: "none", usage: "trace exporter to use, either 'none', 'stdout' or 'otlp'"},
+	{key: "tracing.otlp_endpoint", value: "localhost:55680", usage: "address of the OTLP collector traces are exported to"},
+	{key: "tracing.sample_ratio", value: 1.0, usage: "ratio of traces started by the gateway which are sampled"},
+	{key: "auth.enabled", value: true, usage: "authenticate callers by JWT bearer tokens"},
+	{key: "auth.jwks_file", value: "", usage: "JWKS file containing the keys bearer tokens are signed with"},
+	{key: "auth.issuer", value: "", usage: "required issuer of bearer tokens"},
+	{key: "auth.audience", value: "", usage: "required audience of bearer tokens"},
+	{key: "auth.policy_file", value: "", usage: "file containing the policy granting permissions to roles, the default policy if empty"},
+	{key: "rate_limit.enabled", value: true, usage: "limit the rate of calls by each client"},
+	{key: "rate_limit.rate", value: 10.0, usage: "calls per second each client may make to each method"},
+	{key: "rate_limit.burst", value: 20, usage: "calls each client may make to each method in a burst"},
+	{key: "shutdown_timeout", value: 10 * time.Second, usage: "time to wait for in-flight requests to complete on shutdown"},
+}
+
+// flagName returns the command line flag name for a configuration key.
+func flagName(key string) string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(key)
+}
+
+// addFlags adds the command line flags for the configuration options, or only
+// the options whose keys have one of the prefixes if specified.
+func addFlags(flags *pflag.FlagSet, prefixes ...string) {
+	for _, o := range options {
+		if o.usage == "" || !hasPrefix(o.key, prefixes) {
+			continue
+		}
+		switch value := o.value.(type) {
+		case bool:
+			flags.Bool(flagName(o.key), value, o.usage)
+		case string:
+			flags.String(flagName(o.key), value, o.usage)
+		case int:
+			flags.Int(flagName(o.key), value, o.usage)
+		case float64:
+			flags.Float64(flagName(o.key), value, o.usage)
+		case time.Duration:
+			flags.Duration(flagName(o.key), value, o.usage)
+		}
+	}
+}
+
+func hasPrefix(key string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadConfig loads and validates the configuration. In order of precedence configuration is
+// taken from command line flags, environment variables prefixed with GATEWAY_
+// (e.g. GATEWAY_POSTGRES_PASSWORD_FILE), the config file if specified and
+// finally the defaults.
+func loadConfig(flags *pflag.FlagSet, configFile string) (*Config, error) {
+	config, err := readConfig(flags, configFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadPostgresConfig loads the configuration like loadConfig, only validating
+// the postgres configuration.
+func loadPostgresConfig(flags *pflag.FlagSet, configFile string) (*Config, error) {
+	config, err := readConfig(flags, configFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.Postgres.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// readConfig reads the configuration without validating it.
+func readConfig(flags *pflag.FlagSet, configFile string) (*Config, error) {
+	v := viper.New()
+	for _, o := range options {
+		v.SetDefault(o.key, o.value)
+		if f := flags.Lookup(flagName(o.key)); f != nil {
+			if err := v.BindPFlag(o.key, f); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	v.SetEnvPrefix("gateway")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if configFile != "" {
+		v.SetConfigFile(configFile)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("error reading config file '%s': %w", configFile, err)
+		}
+	}
+
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, err
+	}
+
+	var err error
+	config.Postgres.Password, err = readSecret("postgres password", config.Postgres.Password, config.Postgres.PasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	config.PageToken.Secret, err = readSecret("page token secret", config.PageToken.Secret, config.PageToken.SecretFile)
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// readSecret returns the secret read from the file if specified, otherwise
+// the secret value.
+func readSecret(name, value, file string) (string, error) {
+	if file == "" {
+		return value, nil
+	}
+	if value != "" {
+		return "", fmt.Errorf("only one of %s and %s file can be specified", name, name)
+	}
+	b, err := ioutil.ReadFile(file) // #nosec G304 file is provided by the operator.
+	if err != nil {
+		return "", fmt.Errorf("error reading %s file: %w", name, err)
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// Validate validates the configuration.
+func (c *Config) Validate() error {
+	if err := validateAddress("grpc address", c.GRPC.Address); err != nil {
+		return err
+	}
+	if err := validateAddress("http address", c.HTTP.Address); err != nil {
+		return err
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return err
+	}
+	switch c.Repository {
+	case "memory":
+	case "postgres":
+		if err := c.Postgres.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown repository '%s'", c.Repository)
+	}
+	if c.Health.Interval <= 0 {
+		return fmt.Errorf("invalid health interval %s", c.Health.Interval)
+	}
+	if c.Health.Timeout <= 0 {
+		return fmt.Errorf("invalid health timeout %s", c.Health.Timeout)
+	}
+	if err := c.Purge.Validate(); err != nil {
+		return err
+	}
+	if c.Watch.History < 1 {
+		return fmt.Errorf("invalid watch history %d", c.Watch.History)
+	}
+	if c.Batch.MaxSize < 1 {
+		return fmt.Errorf("invalid batch max size %d", c.Batch.MaxSize)
+	}
+	if err := c.Tracing.Validate(); err != nil {
+		return err
+	}
+	if c.Auth.Enabled && c.Auth.JWKSFile == "" {
+		return errors.New("auth jwks file is empty")
+	}
+	if err := c.RateLimit.Validate(); err != nil {
+		return err
+	}
+	if c.ShutdownTimeout < 0 {
+		return fmt.Errorf("invalid shutdown timeout %s", c.ShutdownTimeout)
+	}
+	return nil
+}
+
+// Validate validates the postgres configuration.
+func (c *PostgresConfig) Validate() error {
+	if c.Host == "" {
+		return errors.New("postgres host is empty")
+	}
+	if c.Port < 1 || c.Port > 65535 {
+		return fmt.Errorf("invalid postgres port %d", c.Port)
+	}
+	if c.User == "" {
+		return errors.New("postgres user is empty")
+	}
+	if c.DBName == "" {
+		return errors.New("postgres database name is empty")
+	}
+	switch c.SSLMode {
+	case "disable", "require", "verify-ca", "verify-full":
+	default:
+		return fmt.Errorf("unknown postgres sslmode '%s'", c.SSLMode)
+	}
+	if c.StatementTimeout < 0 {
+		return fmt.Errorf("invalid postgres statement timeout %s", c.StatementTimeout)
+	}
+	if c.MaxOpenConns < 0 {
+		return fmt.Errorf("invalid postgres max open connections %d", c.MaxOpenConns)
+	}
+	if c.MaxIdleConns < 1 {
+		return fmt.Errorf("invalid postgres max idle connections %d", c.MaxIdleConns)
+	}
+	if c.ConnMaxLifetime < 0 {
+		return fmt.Errorf("invalid postgres connection max lifetime %s", c.ConnMaxLifetime)
+	}
+	return nil
+}
+
+// Validate validates the TLS configuration.
+func (c *TLSConfig) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("tls cert file and key file must be specified together")
+	}
+	if !c.Enabled() && c.ClientCAFile != "" {
+		return errors.New("tls client ca file requires a tls cert file")
+	}
+	if c.ReloadInterval <= 0 {
+		return fmt.Errorf("invalid tls reload interval %s", c.ReloadInterval)
+	}
+	return nil
+}
+
+// Validate validates the rate limit configuration.
+func (c *RateLimitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Rate <= 0 {
+		return fmt.Errorf("invalid rate limit rate %g", c.Rate)
+	}
+	if c.Burst < 1 {
+		return fmt.Errorf("invalid rate limit burst %d", c.Burst)
+	}
+	methods := make(map[string]bool, len(c.Methods))
+	for _, m := range c.Methods {
+		if m.Method == "" {
+			return errors.New("rate limit method is empty")
+		}
+		if methods[m.Method] {
+			return fmt.Errorf("duplicate rate limit for method '%s'", m.Method)
+		}
+		methods[m.Method] = true
+		if m.Rate <= 0 {
+			return fmt.Errorf("invalid rate limit rate %g for method '%s'", m.Rate, m.Method)
+		}
+		if m.Burst < 1 {
+			return fmt.Errorf("invalid rate limit burst %d for method '%s'", m.Burst, m.Method)
+		}
+	}
+	return nil
+}
+
+// Validate validates the tracing configuration.
+func (c *TracingConfig) Validate() error {
+	switch c.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if err := validateAddress("otlp endpoint", c.OTLPEndpoint); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown trace exporter '%s'", c.Exporter)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("invalid trace sample ratio %g", c.SampleRatio)
+	}
+	return nil
+}
+
+func validateAddress(name, address string) error {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return fmt.Errorf("invalid %s '%s': %w", name, address, err)
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFlagSet(args ...string) *pflag.FlagSet {
+	flags := pflag.NewFlagSet("gateway", pflag.ContinueOnError)
+	addFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		panic(err)
+	}
+	return flags
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadConfig_ReturnsDefaults(t *testing.T) {
+	config, err := loadConfig(newFlagSet("--auth-jwks-file=jwks.json"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, &Config{
+		GRPC:       GRPCConfig{Address: "127.0.0.1:50000"},
+		HTTP:       HTTPConfig{Address: ":8080"},
+		TLS:        TLSConfig{ReloadInterval: 30 * time.Second},
+		Repository: "postgres",
+		Postgres: PostgresConfig{
+			Host:             "localhost",
+			Port:             5432,
+			User:             "postgres",
+			DBName:           "beers",
+			SSLMode:          "disable",
+			StatementTimeout: 5 * time.Second,
+			MaxOpenConns:     20,
+			MaxIdleConns:     5,
+			ConnMaxLifetime:  30 * time.Minute,
+			AutoMigrate:      false,
+		},
+		Health:          HealthConfig{Interval: 10 * time.Second, Timeout: 2 * time.Second},
+		Purge:           PurgeConfig{Enabled: true, Retention: 720 * time.Hour, Interval: time.Hour},
+		Watch:           WatchConfig{History: 1000},
+		Batch:           BatchConfig{MaxSize: 100},
+		Tracing:         TracingConfig{Exporter: "none", OTLPEndpoint: "localhost:55680", SampleRatio: 1},
+		Auth:            AuthConfig{Enabled: true, JWKSFile: "jwks.json"},
+		RateLimit:       RateLimitConfig{Enabled: true, Rate: 10, Burst: 20},
+		ShutdownTimeout: 10 * time.Second,
+	}, config)
+}
+
+func TestLoadConfig_ReadsConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configFile := writeFile(t, dir, "config.yaml", `
+grpc:
+  address: 0.0.0.0:50001
+repository: memory
+postgres:
+  host: db
+  port: 5433
+tls:
+  cert_file: /etc/gateway/tls.crt
+  key_file: /etc/gateway/tls.key
+auth:
+  enabled: false
+rate_limit:
+  methods:
+    - method: /BeerService/ListBeers
+      rate: 0.5
+      burst: 5
+`)
+	config, err := loadConfig(newFlagSet(), configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.0.0.0:50001", config.GRPC.Address)
+	assert.Equal(t, ":8080", config.HTTP.Address)
+	assert.Equal(t, "memory", config.Repository)
+	assert.Equal(t, "db", config.Postgres.Host)
+	assert.Equal(t, 5433, config.Postgres.Port)
+	assert.Equal(t, "/etc/gateway/tls.crt", config.TLS.CertFile)
+	assert.Equal(t, "/etc/gateway/tls.key", config.TLS.KeyFile)
+	assert.False(t, config.Auth.Enabled)
+	assert.Equal(t, RateLimitConfig{Enabled: true, Rate: 10, Burst: 20, Methods: []MethodRateLimitConfig{
+		{Method: "/BeerService/ListBeers", Rate: 0.5, Burst: 5},
+	}}, config.RateLimit)
+}
+
+func TestLoadConfig_WhenConfigFileDoesNotExist_ReturnsError(t *testing.T) {
+	_, err := loadConfig(newFlagSet(), "does-not-exist.yaml")
+	assert.NotNil(t, err)
+}
+
+func TestLoadConfig_EnvironmentOverridesConfigFile_FlagsOverrideEnvironment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configFile := writeFile(t, dir, "config.yaml", `
+postgres:
+  host: file
+  user: file
+  dbname: file
+auth:
+  jwks_file: file
+  policy_file: policy
+`)
+	os.Setenv("GATEWAY_POSTGRES_HOST", "env")
+	os.Setenv("GATEWAY_POSTGRES_USER", "env")
+	defer os.Unsetenv("GATEWAY_POSTGRES_HOST")
+	defer os.Unsetenv("GATEWAY_POSTGRES_USER")
+
+	config, err := loadConfig(newFlagSet("--postgres-host=flag", "--shutdown-timeout=1m", "--tracing-sample-ratio=0.5"), configFile)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Minute, config.ShutdownTimeout)
+	assert.Equal(t, 0.5, config.Tracing.SampleRatio)
+	assert.Equal(t, "flag", config.Postgres.Host)
+	assert.Equal(t, "env", config.Postgres.User)
+	assert.Equal(t, "file", config.Postgres.DBName)
+	assert.Equal(t, "file", config.Auth.JWKSFile)
+	assert.Equal(t, "policy", config.Auth.PolicyFile)
+}
+
+func TestLoadConfig_ReadsSecretsFromFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	passwordFile := writeFile(t, dir, "password", "ilovebeer\n")
+	secretFile := writeFile(t, dir, "secret", "signing secret")
+
+	config, err := loadConfig(newFlagSet(
+		"--auth-enabled=false",
+		"--postgres-password-file="+passwordFile,
+		"--page-token-secret-file="+secretFile,
+	), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "ilovebeer", config.Postgres.Password)
+	assert.Equal(t, "signing secret", config.PageToken.Secret)
+}
+
+func TestLoadConfig_ReadsSecretsFromEnvironment(t *testing.T) {
+	os.Setenv("GATEWAY_POSTGRES_PASSWORD", "ilovebeer")
+	defer os.Unsetenv("GATEWAY_POSTGRES_PASSWORD")
+
+	config, err := loadConfig(newFlagSet("--auth-enabled=false"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "ilovebeer", config.Postgres.Password)
+}
+
+func TestLoadConfig_WhenSecretValueAndFileSpecified_ReturnsError(t *testing.T) {
+	os.Setenv("GATEWAY_POSTGRES_PASSWORD", "ilovebeer")
+	defer os.Unsetenv("GATEWAY_POSTGRES_PASSWORD")
+
+	_, err := loadConfig(newFlagSet("--postgres-password-file=password"), "")
+	assert.EqualError(t, err, "only one of postgres password and postgres password file can be specified")
+}
+
+func TestLoadConfig_WhenSecretFileDoesNotExist_ReturnsError(t *testing.T) {
+	_, err := loadConfig(newFlagSet("--postgres-password-file=does-not-exist"), "")
+	assert.NotNil(t, err)
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+	valid := func() *Config {
+		return &Config{
+			GRPC:       GRPCConfig{Address: "127.0.0.1:50000"},
+			HTTP:       HTTPConfig{Address: ":8080"},
+			TLS:        TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", ReloadInterval: 30 * time.Second},
+			Repository: "postgres",
+			Postgres:   PostgresConfig{Host: "localhost", Port: 5432, User: "postgres", DBName: "beers", SSLMode: "disable", MaxIdleConns: 5},
+			Health:     HealthConfig{Interval: 10 * time.Second, Timeout: 2 * time.Second},
+			Purge:      PurgeConfig{Enabled: true, Retention: 720 * time.Hour, Interval: time.Hour},
+			Watch:      WatchConfig{History: 1000},
+			Batch:      BatchConfig{MaxSize: 100},
+			Tracing:    TracingConfig{Exporter: "none", OTLPEndpoint: "localhost:55680", SampleRatio: 1},
+			Auth:       AuthConfig{Enabled: true, JWKSFile: "jwks.json"},
+			RateLimit: RateLimitConfig{Enabled: true, Rate: 10, Burst: 20, Methods: []MethodRateLimitConfig{
+				{Method: "/BeerService/ListBeers", Rate: 1, Burst: 5},
+			}},
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		err    string
+	}{
+		{
+			name:   "all good",
+			modify: func(c *Config) {},
+		},
+		{
+			name:   "invalid grpc address",
+			modify: func(c *Config) { c.GRPC.Address = "localhost" },
+			err:    "invalid grpc address 'localhost': address localhost: missing port in address",
+		},
+		{
+			name:   "invalid http address",
+			modify: func(c *Config) { c.HTTP.Address = "" },
+			err:    "invalid http address '': missing port in address",
+		},
+		{
+			name:   "tls disabled",
+			modify: func(c *Config) { c.TLS.CertFile = ""; c.TLS.KeyFile = "" },
+		},
+		{
+			name:   "missing tls key file",
+			modify: func(c *Config) { c.TLS.KeyFile = "" },
+			err:    "tls cert file and key file must be specified together",
+		},
+		{
+			name:   "missing tls cert file",
+			modify: func(c *Config) { c.TLS.CertFile = "" },
+			err:    "tls cert file and key file must be specified together",
+		},
+		{
+			name:   "tls client ca file",
+			modify: func(c *Config) { c.TLS.ClientCAFile = "ca.pem" },
+		},
+		{
+			name:   "tls client ca file without cert file",
+			modify: func(c *Config) { c.TLS = TLSConfig{ClientCAFile: "ca.pem", ReloadInterval: time.Second} },
+			err:    "tls client ca file requires a tls cert file",
+		},
+		{
+			name:   "invalid tls reload interval",
+			modify: func(c *Config) { c.TLS.ReloadInterval = 0 },
+			err:    "invalid tls reload interval 0s",
+		},
+		{
+			name:   "unknown repository",
+			modify: func(c *Config) { c.Repository = "mongo" },
+			err:    "unknown repository 'mongo'",
+		},
+		{
+			name:   "memory repository ignores postgres",
+			modify: func(c *Config) { c.Repository = "memory"; c.Postgres = PostgresConfig{} },
+		},
+		{
+			name:   "invalid health interval",
+			modify: func(c *Config) { c.Health.Interval = 0 },
+			err:    "invalid health interval 0s",
+		},
+		{
+			name:   "invalid health timeout",
+			modify: func(c *Config) { c.Health.Timeout = -time.Second },
+			err:    "invalid health timeout -1s",
+		},
+		{
+			name:   "invalid purge retention",
+			modify: func(c *Config) { c.Purge.Retention = -time.Hour },
+			err:    "invalid purge retention -1h0m0s",
+		},
+		{
+			name:   "invalid purge interval",
+			modify: func(c *Config) { c.Purge.Interval = 0 },
+			err:    "invalid purge interval 0s",
+		},
+		{
+			name:   "purge disabled",
+			modify: func(c *Config) { c.Purge = PurgeConfig{} },
+		},
+		{
+			name:   "invalid watch history",
+			modify: func(c *Config) { c.Watch.History = 0 },
+			err:    "invalid watch history 0",
+		},
+		{
+			name:   "invalid batch max size",
+			modify: func(c *Config) { c.Batch.MaxSize = 0 },
+			err:    "invalid batch max size 0",
+		},
+		{
+			name:   "unknown trace exporter",
+			modify: func(c *Config) { c.Tracing.Exporter = "zipkin" },
+			err:    "unknown trace exporter 'zipkin'",
+		},
+		{
+			name:   "invalid otlp endpoint",
+			modify: func(c *Config) { c.Tracing.Exporter = "otlp"; c.Tracing.OTLPEndpoint = "collector" },
+			err:    "invalid otlp endpoint 'collector': address collector: missing port in address",
+		},
+		{
+			name:   "invalid trace sample ratio",
+			modify: func(c *Config) { c.Tracing.SampleRatio = 1.5 },
+			err:    "invalid trace sample ratio 1.5",
+		},
+		{
+			name:   "missing auth jwks file",
+			modify: func(c *Config) { c.Auth.JWKSFile = "" },
+			err:    "auth jwks file is empty",
+		},
+		{
+			name:   "auth disabled ignores jwks file",
+			modify: func(c *Config) { c.Auth = AuthConfig{} },
+		},
+		{
+			name:   "invalid rate limit rate",
+			modify: func(c *Config) { c.RateLimit.Rate = 0 },
+			err:    "invalid rate limit rate 0",
+		},
+		{
+			name:   "invalid rate limit burst",
+			modify: func(c *Config) { c.RateLimit.Burst = 0 },
+			err:    "invalid rate limit burst 0",
+		},
+		{
+			name:   "rate limit disabled ignores limits",
+			modify: func(c *Config) { c.RateLimit = RateLimitConfig{} },
+		},
+		{
+			name:   "missing rate limit method",
+			modify: func(c *Config) { c.RateLimit.Methods[0].Method = "" },
+			err:    "rate limit method is empty",
+		},
+		{
+			name: "duplicate rate limit method",
+			modify: func(c *Config) {
+				c.RateLimit.Methods = append(c.RateLimit.Methods, c.RateLimit.Methods[0])
+			},
+			err: "duplicate rate limit for method '/BeerService/ListBeers'",
+		},
+		{
+			name:   "invalid method rate limit rate",
+			modify: func(c *Config) { c.RateLimit.Methods[0].Rate = -1 },
+			err:    "invalid rate limit rate -1 for method '/BeerService/ListBeers'",
+		},
+		{
+			name:   "invalid method rate limit burst",
+			modify: func(c *Config) { c.RateLimit.Methods[0].Burst = 0 },
+			err:    "invalid rate limit burst 0 for method '/BeerService/ListBeers'",
+		},
+		{
+			name:   "invalid shutdown timeout",
+			modify: func(c *Config) { c.ShutdownTimeout = -time.Second },
+			err:    "invalid shutdown timeout -1s",
+		},
+		{
+			name:   "missing postgres host",
+			modify: func(c *Config) { c.Postgres.Host = "" },
+			err:    "postgres host is empty",
+		},
+		{
+			name:   "invalid postgres port",
+			modify: func(c *Config) { c.Postgres.Port = 70000 },
+			err:    "invalid postgres port 70000",
+		},
+		{
+			name:   "missing postgres user",
+			modify: func(c *Config) { c.Postgres.User = "" },
+			err:    "postgres user is empty",
+		},
+		{
+			name:   "missing postgres database name",
+			modify: func(c *Config) { c.Postgres.DBName = "" },
+			err:    "postgres database name is empty",
+		},
+		{
+			name:   "postgres sslmode",
+			modify: func(c *Config) { c.Postgres.SSLMode = "verify-full"; c.Postgres.SSLRootCert = "ca.pem" },
+		},
+		{
+			name:   "unknown postgres sslmode",
+			modify: func(c *Config) { c.Postgres.SSLMode = "prefer" },
+			err:    "unknown postgres sslmode 'prefer'",
+		},
+		{
+			name:   "invalid postgres statement timeout",
+			modify: func(c *Config) { c.Postgres.StatementTimeout = -time.Second },
+			err:    "invalid postgres statement timeout -1s",
+		},
+		{
+			name:   "invalid postgres max open connections",
+			modify: func(c *Config) { c.Postgres.MaxOpenConns = -1 },
+			err:    "invalid postgres max open connections -1",
+		},
+		{
+			name:   "invalid postgres max idle connections",
+			modify: func(c *Config) { c.Postgres.MaxIdleConns = 0 },
+			err:    "invalid postgres max idle connections 0",
+		},
+		{
+			name:   "invalid postgres connection max lifetime",
+			modify: func(c *Config) { c.Postgres.ConnMaxLifetime = -time.Minute },
+			err:    "invalid postgres connection max lifetime -1m0s",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			config := valid()
+			test.modify(config)
+			err := config.Validate()
+			if test.err == "" {
+				assert.Nil(s, err)
+			} else {
+				assert.EqualError(s, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var migrateSteps int
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate the postgres schema",
+	Long: `migrate the postgres schema.
+
+Applied migrations are recorded in the SCHEMA_MIGRATIONS table. The postgres
+connection is configured like the gateway.`,
+}
+
+// migrateUpCmd represents the migrate up command
+var migrateUpCmd = &cobra.Command{
+	Use:     "up",
+	Short:   "apply the pending migrations",
+	Example: `gateway migrate up --config=config.yaml`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrator(cmd, func(logger *logrus.Logger, migrator *infrastructure.Migrator) error {
+			return migrateUp(context.Background(), logger, migrator)
+		})
+	},
+}
+
+// migrateDownCmd represents the migrate down command
+var migrateDownCmd = &cobra.Command{
+	Use:     "down",
+	Short:   "revert the last applied migrations",
+	Example: `gateway migrate down --steps=1 --config=config.yaml`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrator(cmd, func(logger *logrus.Logger, migrator *infrastructure.Migrator) error {
+			reverted, err := migrator.Down(context.Background(), migrateSteps)
+			if err != nil {
+				return err
+			}
+			for _, migration := range reverted {
+				logger.Infof("reverted migration %d '%s'", migration.Version, migration.Description)
+			}
+			return nil
+		})
+	},
+}
+
+// migrateStatusCmd represents the migrate status command
+var migrateStatusCmd = &cobra.Command{
+	Use:     "status",
+	Short:   "print the status of the migrations and check for drift",
+	Example: `gateway migrate status --config=config.yaml`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runMigrator(cmd, func(logger *logrus.Logger, migrator *infrastructure.Migrator) error {
+			ctx := context.Background()
+			statuses, err := migrator.Status(ctx)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED")
+			for _, status := range statuses {
+				applied := "pending"
+				if status.AppliedTime != nil {
+					applied = status.AppliedTime.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Description, applied)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			return migrator.Check(ctx)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+	addFlags(migrateCmd.PersistentFlags(), "postgres.")
+	migrateDownCmd.Flags().IntVar(&migrateSteps, "steps", 1, "number of migrations to revert")
+}
+
+// runMigrator runs the function with a migrator of the configured postgres
+// database, exiting if it fails.
+func runMigrator(cmd *cobra.Command, f func(logger *logrus.Logger, migrator *infrastructure.Migrator) error) {
+	logger := newLogger()
+	config, err := loadPostgresConfig(cmd.Flags(), cfgFile)
+	if err != nil {
+		logger.Fatalf("error loading config: %v", err)
+	}
+	db, err := infrastructure.OpenPostgres(newPostgresSettings(config))
+	if err != nil {
+		logger.Fatalf("error opening postgres: %v", err)
+	}
+	migrator, err := infrastructure.NewMigrator(db)
+	if err == nil {
+		err = f(logger, migrator)
+	}
+	if closeErr := db.Close(); closeErr != nil {
+		logger.Errorf("error closing postgres: %v", closeErr)
+	}
+	if err != nil {
+		logger.Fatal(err)
+	}
+}
+
+// migrateUp applies the pending migrations.
+func migrateUp(ctx context.Context, logger *logrus.Logger, migrator *infrastructure.Migrator) error {
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	for _, migration := range applied {
+		logger.Infof("applied migration %d '%s'", migration.Version, migration.Description)
+	}
+	if len(applied) == 0 {
+		logger.Info("schema is up to date")
+	}
+	return nil
+}
@@ -0,0 +1,522 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+	"github.com/bvwells/grpc-gateway-example/pkg/usecases"
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
+
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/joonix/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/instrumentation/grpctrace"
+	"go.opentelemetry.io/otel/instrumentation/othttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var cfgFile string
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "gRPC server and REST gateway for beers",
+	Long: `gRPC server and REST gateway for beers.
+
+Configuration is read from command line flags, environment variables prefixed
+with GATEWAY_ (e.g. GATEWAY_POSTGRES_PASSWORD_FILE) and an optional YAML config
+file, in that order of precedence.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		logger := newLogger()
+		config, err := loadConfig(cmd.Flags(), cfgFile)
+		if err != nil {
+			logger.Fatalf("error loading config: %v", err)
+		}
+		if err := serve(logger, config); err != nil {
+			logger.Fatal(err)
+		}
+	},
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file")
+	addFlags(rootCmd.Flags())
+}
+
+func newLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetLevel(logrus.InfoLevel)
+	logger.SetFormatter(log.NewFormatter())
+	return logger
+}
+
+// beerRepository is a beer repository which can be pinged for health checks.
+type beerRepository interface {
+	usecases.BeerRepository
+	adapters.Pinger
+}
+
+func generateID() string {
+	return uuid.New().String()
+}
+
+func newPostgresSettings(config *Config) *infrastructure.PostgresSettings {
+	return &infrastructure.PostgresSettings{
+		Host:             config.Postgres.Host,
+		Port:             config.Postgres.Port,
+		User:             config.Postgres.User,
+		Password:         config.Postgres.Password,
+		DBName:           config.Postgres.DBName,
+		SSLMode:          config.Postgres.SSLMode,
+		SSLRootCert:      config.Postgres.SSLRootCert,
+		StatementTimeout: config.Postgres.StatementTimeout,
+		MaxOpenConns:     config.Postgres.MaxOpenConns,
+		MaxIdleConns:     config.Postgres.MaxIdleConns,
+		ConnMaxLifetime:  config.Postgres.ConnMaxLifetime,
+	}
+}
+
+// newBeerRepository creates the beer repository and the audit repository the
+// beer repository records the mutations of beers in. Postgres repositories
+// use the connection pool db, which is nil for other repositories.
+func newBeerRepository(config *Config, db *sql.DB) (beerRepository, usecases.AuditRepository, error) {
+	switch config.Repository {
+	case "memory":
+		audit := infrastructure.NewMemoryAuditRepository(generateID)
+		return infrastructure.NewMemoryBeerRepository(generateID, audit), audit, nil
+	case "postgres":
+		return infrastructure.NewPostgresBeerRepository(db, generateID), infrastructure.NewPostgresAuditRepository(db), nil
+	default:
+		return nil, nil, fmt.Errorf("unknown repository '%s'", config.Repository)
+	}
+}
+
+// openPostgres opens the connection pool to postgres shared by the
+// repositories, applying the pending migrations of the schema if configured.
+func openPostgres(logger *logrus.Logger, config *Config) (*sql.DB, error) {
+	db, err := infrastructure.OpenPostgres(newPostgresSettings(config))
+	if err != nil {
+		return nil, err
+	}
+	if config.Postgres.AutoMigrate {
+		migrator, err := infrastructure.NewMigrator(db)
+		if err == nil {
+			err = migrateUp(context.Background(), logger, migrator)
+		}
+		if err != nil {
+			db.Close()
+			return nil, fmt.Errorf("error migrating schema: %w", err)
+		}
+	}
+	return db, nil
+}
+
+// newAPIKeyRepository creates the API key repository, stored alongside the
+// beers.
+func newAPIKeyRepository(config *Config, db *sql.DB) (usecases.APIKeyRepository, error) {
+	switch config.Repository {
+	case "memory":
+		return infrastructure.NewMemoryAPIKeyRepository(generateID), nil
+	case "postgres":
+		return infrastructure.NewPostgresAPIKeyRepository(db, generateID), nil
+	default:
+		return nil, fmt.Errorf("unknown repository '%s'", config.Repository)
+	}
+}
+
+func newBeerService(config *Config, interactor adapters.BeerInteractor) (*adapters.BeerService, error) {
+	// Without a configured secret page tokens are signed with a key which
+	// changes on every start, so page tokens do not outlive the gateway.
+	pageTokenKey := []byte(config.PageToken.Secret)
+	if len(pageTokenKey) == 0 {
+		pageTokenKey = make([]byte, 32)
+		if _, err := rand.Read(pageTokenKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return adapters.NewBeerService(interactor, adapters.NewPageTokenCodec(pageTokenKey)), nil
+}
+
+// publicMethods are the gRPC methods which do not require authentication or
+// authorization.
+var publicMethods = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+// newAuthenticator creates an authenticator which authenticates callers by
+// their API key or, without one, by their JWT bearer token.
+func newAuthenticator(config *Config, apiKeys adapters.APIKeyInteractor) (adapters.Authenticator, error) {
+	jwks, err := ioutil.ReadFile(config.Auth.JWKSFile) // #nosec G304 file is provided by the operator.
+	if err != nil {
+		return nil, fmt.Errorf("error reading jwks file: %w", err)
+	}
+	keys, err := adapters.ParseJWKS(jwks)
+	if err != nil {
+		return nil, err
+	}
+	jwtAuthenticator := adapters.NewJWTAuthenticator(keys, config.Auth.Issuer, config.Auth.Audience)
+	return adapters.NewAPIKeyAuthenticator(apiKeys, jwtAuthenticator), nil
+}
+
+func newPolicy(config *Config) (*domain.Policy, error) {
+	if config.Auth.PolicyFile == "" {
+		return domain.DefaultPolicy(), nil
+	}
+	policy, err := ioutil.ReadFile(config.Auth.PolicyFile) // #nosec G304 file is provided by the operator.
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy file: %w", err)
+	}
+	return adapters.ParsePolicy(policy)
+}
+
+func newRateLimiter(config *Config) (*adapters.RateLimiter, error) {
+	methodLimits := make(map[string]adapters.RateLimit, len(config.RateLimit.Methods))
+	for _, m := range config.RateLimit.Methods {
+		methodLimits[m.Method] = adapters.RateLimit{Rate: m.Rate, Burst: m.Burst}
+	}
+	return adapters.NewRateLimiter(adapters.RateLimit{
+		Rate:  config.RateLimit.Rate,
+		Burst: config.RateLimit.Burst,
+	}, methodLimits)
+}
+
+// newCredentials returns the transport credentials of the gRPC server and of
+// the HTTP gateway's connection to it, and the TLS config of the HTTP gateway.
+// Certificates are reloaded every reload interval until the context is done.
+// The gateway's connection presents the server certificate, so that it is
+// accepted when the gRPC server verifies client certificates.
+func newCredentials(ctx context.Context, logger *logrus.Logger, config *Config) (credentials.TransportCredentials, credentials.TransportCredentials, *tls.Config, error) {
+	reloader, err := infrastructure.NewCertificateReloader(logger, &infrastructure.TLSSettings{
+		CertFile:     config.TLS.CertFile,
+		KeyFile:      config.TLS.KeyFile,
+		ClientCAFile: config.TLS.ClientCAFile,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	clientConfig, err := reloader.ClientConfig(config.TLS.CAFile, config.TLS.ServerName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	go reloader.Run(ctx, config.TLS.ReloadInterval)
+	return credentials.NewTLS(reloader.ServerConfig(true)),
+		credentials.NewTLS(clientConfig),
+		reloader.ServerConfig(false),
+		nil
+}
+
+// serve serves the gRPC server and HTTP gateway until either fails or the
+// process receives SIGINT or SIGTERM, then shuts them down gracefully.
+func serve(logger *logrus.Logger, config *Config) error {
+	tp, err := infrastructure.NewTraceProvider(&infrastructure.TracingSettings{
+		ServiceName:  "gateway",
+		Exporter:     config.Tracing.Exporter,
+		OTLPEndpoint: config.Tracing.OTLPEndpoint,
+		SampleRatio:  config.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating trace provider: %w", err)
+	}
+	defer func() {
+		if err := tp.Close(); err != nil {
+			logger.Errorf("error closing trace provider: %v", err)
+		}
+	}()
+	global.SetTraceProvider(tp)
+	tracer := global.Tracer("github.com/bvwells/grpc-gateway-example/cmd/gateway")
+
+	var db *sql.DB
+	if config.Repository == "postgres" {
+		db, err = openPostgres(logger, config)
+		if err != nil {
+			return fmt.Errorf("error opening postgres: %w", err)
+		}
+		defer func() {
+			if err := db.Close(); err != nil {
+				logger.Errorf("error closing postgres: %v", err)
+			}
+		}()
+	}
+
+	repo, auditRepo, err := newBeerRepository(config, db)
+	if err != nil {
+		return fmt.Errorf("error creating beer repository: %w", err)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+
+	instrumentedRepo, err := infrastructure.NewInstrumentedBeerRepository(repo, registry)
+	if err != nil {
+		return fmt.Errorf("error instrumenting beer repository: %w", err)
+	}
+
+	events := infrastructure.NewMemoryBeerEventBroker(config.Watch.History, generateID)
+	interactor := usecases.NewBeerInteractor(instrumentedRepo, auditRepo, events, config.Batch.MaxSize)
+	service, err := newBeerService(config, interactor)
+	if err != nil {
+		return fmt.Errorf("error creating beer service: %w", err)
+	}
+
+	apiKeyRepo, err := newAPIKeyRepository(config, db)
+	if err != nil {
+		return fmt.Errorf("error creating api key repository: %w", err)
+	}
+	var policy *domain.Policy
+	if config.Auth.Enabled {
+		policy, err = newPolicy(config)
+		if err != nil {
+			return fmt.Errorf("error creating policy: %w", err)
+		}
+	}
+	apiKeys := usecases.NewAPIKeyInteractor(apiKeyRepo, policy)
+
+	address := config.GRPC.Address
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("error listening on port '%s': %w", address, err)
+	}
+
+	grpcMetrics := grpc_prometheus.NewServerMetrics()
+	grpcMetrics.EnableHandlingTimeHistogram()
+	registry.MustRegister(grpcMetrics)
+
+	httpMetrics, err := adapters.NewHTTPMetricsMiddleware(registry)
+	if err != nil {
+		return fmt.Errorf("error creating http metrics: %w", err)
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpctrace.UnaryServerInterceptor(tracer),
+		grpcMetrics.UnaryServerInterceptor(),
+		grpc_logrus.UnaryServerInterceptor(logrus.NewEntry(logger)),
+		grpc_recovery.UnaryServerInterceptor(),
+		adapters.NewRequestIDUnaryServerInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpctrace.StreamServerInterceptor(tracer),
+		grpcMetrics.StreamServerInterceptor(),
+		grpc_logrus.StreamServerInterceptor(logrus.NewEntry(logger)),
+		grpc_recovery.StreamServerInterceptor(),
+		adapters.NewRequestIDStreamServerInterceptor(),
+	}
+	if config.Auth.Enabled {
+		authenticator, err := newAuthenticator(config, apiKeys)
+		if err != nil {
+			return fmt.Errorf("error creating authenticator: %w", err)
+		}
+		unaryInterceptors = append(unaryInterceptors,
+			adapters.NewAuthUnaryServerInterceptor(authenticator, publicMethods...),
+			adapters.NewAuthzUnaryServerInterceptor(policy, publicMethods...))
+		streamInterceptors = append(streamInterceptors,
+			adapters.NewAuthStreamServerInterceptor(authenticator, publicMethods...),
+			adapters.NewAuthzStreamServerInterceptor(policy, publicMethods...))
+	} else {
+		logger.Warn("authentication and authorization are disabled")
+	}
+	if config.RateLimit.Enabled {
+		limiter, err := newRateLimiter(config)
+		if err != nil {
+			return fmt.Errorf("error creating rate limiter: %w", err)
+		}
+		unaryInterceptors = append(unaryInterceptors,
+			adapters.NewRateLimitUnaryServerInterceptor(limiter, publicMethods...))
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc_middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
+	}
+	dialOptions := []grpc.DialOption{grpc.WithBlock()}
+	var httpTLSConfig *tls.Config
+	if config.TLS.Enabled() {
+		reloadCtx, stopReloads := context.WithCancel(context.Background())
+		defer stopReloads()
+		serverCreds, clientCreds, tlsConfig, err := newCredentials(reloadCtx, logger, config)
+		if err != nil {
+			return fmt.Errorf("error creating tls credentials: %w", err)
+		}
+		serverOptions = append(serverOptions, grpc.Creds(serverCreds))
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(clientCreds))
+		httpTLSConfig = tlsConfig
+	} else {
+		logger.Warn("tls is disabled")
+		dialOptions = append(dialOptions, grpc.WithInsecure())
+	}
+
+	s := grpc.NewServer(serverOptions...)
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, healthServer)
+	beers.RegisterBeerServiceServer(s, service)
+	beers.RegisterAPIKeyServiceServer(s, adapters.NewAPIKeyService(apiKeys))
+	grpcMetrics.InitializeMetrics(s)
+
+	checker := adapters.NewHealthChecker(logger, repo, healthServer, "BeerService", config.Health.Timeout)
+	checkCtx, stopChecks := context.WithCancel(context.Background())
+	defer stopChecks()
+	go checker.Run(checkCtx, config.Health.Interval)
+
+	if config.Purge.Enabled {
+		purger := adapters.NewBeerPurger(logger, interactor, config.Purge.Retention)
+		purgeCtx, stopPurges := context.WithCancel(context.Background())
+		defer stopPurges()
+		go purger.Run(purgeCtx, config.Purge.Interval)
+	}
+
+	// Serving errors are buffered so that neither server blocks on exit when
+	// the other has already failed.
+	errs := make(chan error, 2)
+
+	logger.Infof("starting gRPC service at '%s'", address)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			errs <- fmt.Errorf("error serving gRPC server: %w", err)
+		}
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), address, dialOptions...)
+	if err != nil {
+		s.Stop()
+		return fmt.Errorf("error dialing gRPC server: %w", err)
+	}
+	defer conn.Close()
+
+	// Register gRPC server endpoint
+	// Note: Make sure the gRPC server is running properly and accessible
+	mux := runtime.NewServeMux(
+		runtime.WithProtoErrorHandler(adapters.NewProtoErrorHandler(logger)),
+		runtime.WithIncomingHeaderMatcher(adapters.NewIncomingHeaderMatcher()),
+		runtime.WithOutgoingHeaderMatcher(adapters.NewOutgoingHeaderMatcher()),
+		runtime.WithForwardResponseOption(adapters.NewForwardResponseOption()),
+		runtime.WithMetadata(adapters.NewAnnotator()),
+	)
+	err = beers.RegisterBeerServiceHandler(context.Background(), mux, conn)
+	if err != nil {
+		s.Stop()
+		return fmt.Errorf("error registering beer service handler: %w", err)
+	}
+	err = beers.RegisterAPIKeyServiceHandler(context.Background(), mux, conn)
+	if err != nil {
+		s.Stop()
+		return fmt.Errorf("error registering api key service handler: %w", err)
+	}
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/healthz", checker.LivenessHandler())
+	httpMux.Handle("/readyz", checker.ReadinessHandler())
+	httpMux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	// Watches and exports are not traced, as the traced response writer cannot
+	// be flushed, which the gateway requires to stream responses.
+	httpMux.Handle("/", othttp.NewHandler(httpMetrics(mux), "gateway", othttp.WithTracer(tracer),
+		othttp.WithFilter(func(r *http.Request) bool {
+			return !strings.HasSuffix(r.URL.Path, ":watch") && !strings.HasSuffix(r.URL.Path, ":export")
+		})))
+
+	logger.Infof("starting http service at '%s'", config.HTTP.Address)
+
+	// Start HTTP server (and proxy calls to gRPC server endpoint)
+	httpServer := &http.Server{
+		Addr:      config.HTTP.Address,
+		Handler:   httpMux,
+		TLSConfig: httpTLSConfig,
+	}
+	go func() {
+		var err error
+		if httpTLSConfig != nil {
+			// The certificate is provided by the TLS config.
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != http.ErrServerClosed {
+			errs <- fmt.Errorf("error serving beer service: %w", err)
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	var serveErr error
+	select {
+	case sig := <-signals:
+		logger.Infof("received signal '%s', shutting down", sig)
+	case serveErr = <-errs:
+		logger.Errorf("shutting down: %v", serveErr)
+	}
+
+	stopChecks()
+	// Watches never complete, so they are ended before the servers are
+	// drained of in-flight requests.
+	events.Close()
+	shutdown(logger, config.ShutdownTimeout, checker, httpServer, s)
+	return serveErr
+}
+
+// shutdown gracefully shuts down the servers. The health checker reports
+// NOT_SERVING first so that no new traffic is routed to the gateway, then the
+// HTTP server and gRPC server are drained of in-flight requests. Requests
+// still in flight when the timeout expires are cancelled.
+func shutdown(logger *logrus.Logger, timeout time.Duration, checker *adapters.HealthChecker, httpServer *http.Server, s *grpc.Server) {
+	checker.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		logger.Errorf("error shutting down http service: %v", err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warn("timed out waiting for gRPC service to stop gracefully")
+		s.Stop()
+	}
+	logger.Info("shut down")
+}
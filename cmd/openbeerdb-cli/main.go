@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
@@ -21,6 +22,10 @@ import (
 // - cat_name
 // - style_name
 // - name_breweries
+// - abv
+// - ibu
+// - srm
+// - descript
 type Beer struct {
 	DatasetID string                 `json:"datasetid"`
 	RecordID  string                 `json:"recordid"`
@@ -59,13 +64,20 @@ func main() {
 	generateID := func() string {
 		return uuid.New().String()
 	}
-	repo, err := infrastructure.NewPostgresBeerRepository(settings, generateID)
+	db, err := infrastructure.OpenPostgres(settings)
 	if err != nil {
 		log.Println(err)
 		os.Exit(1)
 	}
+	defer db.Close()
 
-	interactor := usecases.NewBeerInteractor(repo)
+	repo := infrastructure.NewPostgresBeerRepository(db, generateID)
+	audit := infrastructure.NewPostgresAuditRepository(db)
+
+	// Nothing watches the events of the imported beers.
+	events := infrastructure.NewMemoryBeerEventBroker(1, generateID)
+
+	interactor := usecases.NewBeerInteractor(repo, audit, events, domain.DefaultMaxBatchSize)
 
 	for _, beer := range beers {
 		name := getField(beer, "name")
@@ -74,10 +86,14 @@ func main() {
 		}
 
 		_, err := interactor.CreateBeer(context.Background(), &domain.CreateBeerParams{
-			Name:    name,
-			Type:    getType(getField(beer, "style_name")),
-			Brewer:  getField(beer, "name_breweries"),
-			Country: getField(beer, "country"),
+			Name:        name,
+			Type:        getType(getField(beer, "style_name")),
+			Brewer:      getField(beer, "name_breweries"),
+			Country:     getField(beer, "country"),
+			ABV:         getNumberField(beer, "abv", domain.MaxABV),
+			IBU:         int(getNumberField(beer, "ibu", domain.MaxIBU)),
+			SRM:         getNumberField(beer, "srm", domain.MaxSRM),
+			Description: getDescription(beer),
 		})
 		if err != nil {
 			log.Println(err)
@@ -98,6 +114,31 @@ func getField(beer *Beer, fieldName string) string {
 	return val
 }
 
+// getNumberField returns the number field of the beer, or zero, meaning not
+// known, if the beer has no such field or its value is not between zero and
+// max.
+func getNumberField(beer *Beer, fieldName string, max float64) float64 {
+	field, ok := beer.Fields[fieldName]
+	if !ok {
+		return 0
+	}
+	val, ok := field.(float64)
+	if !ok || val < 0 || val > max {
+		return 0
+	}
+	return val
+}
+
+// getDescription returns the description of the beer, truncated to the
+// longest description of a beer.
+func getDescription(beer *Beer) string {
+	description := []rune(strings.TrimSpace(getField(beer, "descript")))
+	if len(description) > domain.MaxDescriptionLength {
+		description = description[:domain.MaxDescriptionLength]
+	}
+	return string(description)
+}
+
 func getType(in string) domain.BeerType {
 	switch in {
 	case "American-Style Brown Ale":
@@ -3,25 +3,31 @@ module github.com/bvwells/grpc-gateway-example
 go 1.14
 
 require (
+	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/golang/protobuf v1.4.2
 	github.com/golangci/golangci-lint v1.27.0
-	github.com/google/go-cmp v0.4.1 // indirect
 	github.com/google/uuid v1.0.0
 	github.com/grpc-ecosystem/go-grpc-middleware v1.0.0
+	github.com/grpc-ecosystem/go-grpc-prometheus v1.2.0
 	github.com/grpc-ecosystem/grpc-gateway v1.14.5
 	github.com/joonix/log v0.0.0-20200409080653-9c1d2ceb5f1d
 	github.com/lib/pq v1.5.2
 	github.com/mitchellh/go-homedir v1.1.0
+	github.com/prometheus/client_golang v1.7.1
 	github.com/sirupsen/logrus v1.4.2
 	github.com/spf13/cobra v1.0.0
+	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.6.1
-	github.com/stretchr/testify v1.5.1
+	github.com/stretchr/testify v1.6.1
 	github.com/vektra/mockery v1.1.2
+	go.opentelemetry.io/otel v0.10.0
+	go.opentelemetry.io/otel/exporters/otlp v0.10.0
+	go.opentelemetry.io/otel/exporters/stdout v0.10.0
+	go.opentelemetry.io/otel/sdk v0.10.0
 	golang.org/x/net v0.0.0-20200602114024-627f9648deb9 // indirect
-	golang.org/x/sys v0.0.0-20200602225109-6fdc65e7d980 // indirect
 	golang.org/x/tools v0.0.0-20200502202811-ed308ab3e770
 	google.golang.org/genproto v0.0.0-20200605102947-12044bf5ea91
-	google.golang.org/grpc v1.29.1
+	google.golang.org/grpc v1.30.0
 	google.golang.org/protobuf v1.24.0
-	gopkg.in/yaml.v2 v2.3.0 // indirect
+	gopkg.in/yaml.v2 v2.3.0
 )
@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// apiKeyMetadataKey is the metadata key carrying API keys. The gateway
+// forwards the X-Api-Key header as this key.
+const apiKeyMetadataKey = "x-api-key"
+
+// NewAPIKeyAuthenticator creates a new authenticator which authenticates
+// callers by the API key in the x-api-key metadata. Callers without an API
+// key are authenticated by the next authenticator.
+func NewAPIKeyAuthenticator(interactor APIKeyInteractor, next Authenticator) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{interactor: interactor, next: next}
+}
+
+// APIKeyAuthenticator authenticates callers by API keys.
+type APIKeyAuthenticator struct {
+	interactor APIKeyInteractor
+	next       Authenticator
+}
+
+// Authenticate authenticates the caller by the API key in the x-api-key
+// metadata, or by the next authenticator if there is no API key.
+func (a *APIKeyAuthenticator) Authenticate(ctx context.Context) (*domain.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(apiKeyMetadataKey)
+	if len(keys) == 0 {
+		return a.next.Authenticate(ctx)
+	}
+	principal, err := a.interactor.Authenticate(ctx, keys[0])
+	if err != nil {
+		return nil, toError(err)
+	}
+	return principal, nil
+}
@@ -0,0 +1,70 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters/mocks"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func withAPIKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", key))
+}
+
+func TestAPIKeyAuthenticator_Authenticate_WhenAPIKeyValid_ReturnsPrincipal(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	next := &authenticator{err: status.Error(codes.Unauthenticated, "no token")}
+	ctx := withAPIKey("key")
+	expected := &domain.Principal{Subject: "apikey:id", Scopes: []domain.Permission{domain.PermissionListBeers}}
+	interactor.On("Authenticate", ctx, "key").Return(expected, nil)
+
+	actual, err := adapters.NewAPIKeyAuthenticator(interactor, next).Authenticate(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestAPIKeyAuthenticator_Authenticate_WhenAPIKeyInvalid_ReturnsUnauthenticated(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	next := &authenticator{principal: &domain.Principal{Subject: "subject"}}
+	ctx := withAPIKey("key")
+	interactor.On("Authenticate", ctx, "key").Return(nil, domain.NewUnauthenticatedError("invalid api key"))
+
+	_, err := adapters.NewAPIKeyAuthenticator(interactor, next).Authenticate(ctx)
+	assert.Equal(t, status.Error(codes.Unauthenticated, "invalid api key"), err)
+}
+
+func TestAPIKeyAuthenticator_Authenticate_WhenAuthenticateReturnsError_ReturnsInternal(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	ctx := withAPIKey("key")
+	interactor.On("Authenticate", ctx, "key").Return(nil, errors.New("something went wrong"))
+
+	_, err := adapters.NewAPIKeyAuthenticator(interactor, &authenticator{}).Authenticate(ctx)
+	assert.Equal(t, status.Error(codes.Internal, "something went wrong"), err)
+}
+
+func TestAPIKeyAuthenticator_Authenticate_WhenNoAPIKey_UsesNextAuthenticator(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	expected := &domain.Principal{Subject: "subject"}
+	next := &authenticator{principal: expected}
+	for _, ctx := range []context.Context{
+		context.Background(),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token")),
+	} {
+		actual, err := adapters.NewAPIKeyAuthenticator(interactor, next).Authenticate(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	}
+	interactor.AssertNotCalled(t, "Authenticate")
+}
@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyInteractor defines a set of APIs for managing API keys and
+// authenticating callers by them.
+type APIKeyInteractor interface {
+	// CreateAPIKey creates an API key, returning the key itself.
+	CreateAPIKey(ctx context.Context, params *domain.CreateAPIKeyParams) (*domain.APIKey, string, error)
+	// ListAPIKeys lists all API keys.
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+	// RevokeAPIKey revokes an API key.
+	RevokeAPIKey(ctx context.Context, params *domain.RevokeAPIKeyParams) error
+	// Authenticate authenticates a caller by an API key.
+	Authenticate(ctx context.Context, key string) (*domain.Principal, error)
+}
+
+// NewAPIKeyService creates a new API key service.
+func NewAPIKeyService(interactor APIKeyInteractor) *APIKeyService {
+	return &APIKeyService{interactor: interactor}
+}
+
+// APIKeyService implements the APIKeyService service gRPC API.
+type APIKeyService struct {
+	interactor APIKeyInteractor
+}
+
+// CreateAPIKey creates an API key with specified API key parameters.
+func (svc *APIKeyService) CreateAPIKey(ctx context.Context, params *beers.CreateAPIKeyRequest) (*beers.CreateAPIKeyResponse, error) {
+	var expireTime time.Time
+	if params.ExpireTime != nil {
+		var err error
+		expireTime, err = ptypes.Timestamp(params.ExpireTime)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+	}
+	createParams := &domain.CreateAPIKeyParams{
+		Name:       params.Name,
+		ExpireTime: expireTime,
+	}
+	for _, scope := range params.Scopes {
+		createParams.Scopes = append(createParams.Scopes, domain.Permission(scope))
+	}
+
+	apiKey, key, err := svc.interactor.CreateAPIKey(ctx, createParams)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &beers.CreateAPIKeyResponse{ApiKey: toProtoAPIKey(apiKey), Key: key}, nil
+}
+
+// ListAPIKeys lists all API keys.
+func (svc *APIKeyService) ListAPIKeys(ctx context.Context, params *beers.ListAPIKeysRequest) (*beers.ListAPIKeysResponse, error) {
+	keys, err := svc.interactor.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, toError(err)
+	}
+	resp := &beers.ListAPIKeysResponse{ApiKeys: make([]*beers.APIKey, 0, len(keys))}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProtoAPIKey(key))
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey revokes the API key with specified API key identifier.
+func (svc *APIKeyService) RevokeAPIKey(ctx context.Context, params *beers.RevokeAPIKeyRequest) (*empty.Empty, error) {
+	err := svc.interactor.RevokeAPIKey(ctx, &domain.RevokeAPIKeyParams{ID: params.Id})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func toProtoAPIKey(in *domain.APIKey) *beers.APIKey {
+	out := &beers.APIKey{
+		Id:         in.ID,
+		Name:       in.Name,
+		CreateTime: toProtoTimestamp(in.CreateTime),
+		ExpireTime: toProtoTimestamp(in.ExpireTime),
+	}
+	for _, scope := range in.Scopes {
+		out.Scopes = append(out.Scopes, string(scope))
+	}
+	if in.RevokeTime != nil {
+		out.RevokeTime = toProtoTimestamp(*in.RevokeTime)
+	}
+	return out
+}
+
+// toProtoTimestamp converts a time to a timestamp. Times outside the range of
+// timestamps, which the domain never holds, are converted to nil.
+func toProtoTimestamp(in time.Time) *timestamp.Timestamp {
+	out, err := ptypes.TimestampProto(in)
+	if err != nil {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters/mocks"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate mockery -name=APIKeyInteractor -case=underscore
+
+var (
+	createTime = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	expireTime = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func mustTimestampProto(t time.Time) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+func TestNewAPIKeyService_ReturnsAPIKeyService(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	assert.NotNil(t, adapters.NewAPIKeyService(interactor))
+}
+
+func TestCreateAPIKey_WhenCreateAPIKeyReturnsAPIKey_ReturnsAPIKeyAndKey(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	scopes := []domain.Permission{domain.PermissionGetBeers, domain.PermissionListBeers}
+	interactor.On("CreateAPIKey", ctx, &domain.CreateAPIKeyParams{
+		Name:       "name",
+		Scopes:     scopes,
+		ExpireTime: expireTime,
+	}).Return(&domain.APIKey{
+		ID:         "id",
+		Name:       "name",
+		Hash:       []byte("hash"),
+		Scopes:     scopes,
+		CreateTime: createTime,
+		ExpireTime: expireTime,
+	}, "key", nil)
+
+	expected := &beers.CreateAPIKeyResponse{
+		ApiKey: &beers.APIKey{
+			Id:         "id",
+			Name:       "name",
+			Scopes:     []string{"beers.get", "beers.list"},
+			CreateTime: mustTimestampProto(createTime),
+			ExpireTime: mustTimestampProto(expireTime),
+		},
+		Key: "key",
+	}
+	actual, err := service.CreateAPIKey(ctx, &beers.CreateAPIKeyRequest{
+		Name:       "name",
+		Scopes:     []string{"beers.get", "beers.list"},
+		ExpireTime: mustTimestampProto(expireTime),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestCreateAPIKey_WhenCreateAPIKeyReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	const msg = "api key expire time is empty"
+	interactor.On("CreateAPIKey", ctx, &domain.CreateAPIKeyParams{Name: "name"}).
+		Return(nil, "", domain.NewValidationError(msg))
+	_, actual := service.CreateAPIKey(ctx, &beers.CreateAPIKeyRequest{Name: "name"})
+	assert.Equal(t, status.Error(codes.InvalidArgument, msg), actual)
+}
+
+func TestCreateAPIKey_WhenExpireTimeInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	_, err := service.CreateAPIKey(context.Background(), &beers.CreateAPIKeyRequest{
+		Name:       "name",
+		ExpireTime: &timestamp.Timestamp{Nanos: -1},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAPIKeys_WhenListAPIKeysReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	const msg = "something went wrong"
+	interactor.On("ListAPIKeys", ctx).Return(nil, errors.New(msg))
+	_, actual := service.ListAPIKeys(ctx, &beers.ListAPIKeysRequest{})
+	assert.Equal(t, status.Error(codes.Internal, msg), actual)
+}
+
+func TestListAPIKeys_WhenListAPIKeysReturnsAPIKeys_ReturnsAPIKeys(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	revokeTime := createTime.Add(time.Hour)
+	interactor.On("ListAPIKeys", ctx).Return([]*domain.APIKey{
+		{ID: "a", Name: "a", Scopes: []domain.Permission{domain.PermissionListBeers}, CreateTime: createTime, ExpireTime: expireTime},
+		{ID: "b", Name: "b", CreateTime: createTime, ExpireTime: expireTime, RevokeTime: &revokeTime},
+	}, nil)
+
+	expected := &beers.ListAPIKeysResponse{
+		ApiKeys: []*beers.APIKey{
+			{Id: "a", Name: "a", Scopes: []string{"beers.list"}, CreateTime: mustTimestampProto(createTime), ExpireTime: mustTimestampProto(expireTime)},
+			{Id: "b", Name: "b", CreateTime: mustTimestampProto(createTime), ExpireTime: mustTimestampProto(expireTime), RevokeTime: mustTimestampProto(revokeTime)},
+		},
+	}
+	actual, err := service.ListAPIKeys(ctx, &beers.ListAPIKeysRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestRevokeAPIKey_WhenRevokeAPIKeyReturnsNotFoundError_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	const msg = "api key 'id' not found"
+	interactor.On("RevokeAPIKey", ctx, &domain.RevokeAPIKeyParams{ID: "id"}).Return(domain.NewNotFoundError(msg))
+	_, actual := service.RevokeAPIKey(ctx, &beers.RevokeAPIKeyRequest{Id: "id"})
+	assert.Equal(t, status.Error(codes.NotFound, msg), actual)
+}
+
+func TestRevokeAPIKey_WhenRevokeAPIKeyReturnsNil_ReturnsNilError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.APIKeyInteractor{}
+	service := adapters.NewAPIKeyService(interactor)
+	ctx := context.Background()
+	interactor.On("RevokeAPIKey", ctx, &domain.RevokeAPIKeyParams{ID: "id"}).Return(nil)
+	_, err := service.RevokeAPIKey(ctx, &beers.RevokeAPIKeyRequest{Id: "id"})
+	assert.Nil(t, err)
+}
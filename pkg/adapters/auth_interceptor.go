@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+)
+
+// NewAuthUnaryServerInterceptor returns a new unary server interceptor which
+// authenticates callers with the authenticator and places the principal on
+// the context. The public methods, given by their full method name, e.g.
+// "/grpc.health.v1.Health/Check", do not require authentication.
+func NewAuthUnaryServerInterceptor(authenticator Authenticator, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := toSet(publicMethods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		principal, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(domain.NewContextWithPrincipal(ctx, principal), req)
+	}
+}
+
+// NewAuthStreamServerInterceptor returns a new stream server interceptor which
+// authenticates callers with the authenticator and places the principal on
+// the stream context. The public methods do not require authentication.
+func NewAuthStreamServerInterceptor(authenticator Authenticator, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := toSet(publicMethods)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if public[info.FullMethod] {
+			return handler(srv, stream)
+		}
+		principal, err := authenticator.Authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = domain.NewContextWithPrincipal(stream.Context(), principal)
+		return handler(srv, wrapped)
+	}
+}
+
+func toSet(in []string) map[string]bool {
+	set := make(map[string]bool, len(in))
+	for _, s := range in {
+		set[s] = true
+	}
+	return set
+}
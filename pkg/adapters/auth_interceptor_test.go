@@ -0,0 +1,108 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type authenticator struct {
+	principal *domain.Principal
+	err       error
+}
+
+func (a *authenticator) Authenticate(ctx context.Context) (*domain.Principal, error) {
+	return a.principal, a.err
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestNewAuthUnaryServerInterceptor_WhenAuthenticated_PlacesPrincipalOnContext(t *testing.T) {
+	t.Parallel()
+	expected := &domain.Principal{Subject: "subject"}
+	interceptor := adapters.NewAuthUnaryServerInterceptor(&authenticator{principal: expected})
+
+	var actual *domain.Principal
+	resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			actual, _ = domain.PrincipalFromContext(ctx)
+			return "resp", nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "resp", resp)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNewAuthUnaryServerInterceptor_WhenNotAuthenticated_ReturnsError(t *testing.T) {
+	t.Parallel()
+	expected := status.Error(codes.Unauthenticated, "no token")
+	interceptor := adapters.NewAuthUnaryServerInterceptor(&authenticator{err: expected})
+
+	called := false
+	_, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+	assert.Equal(t, expected, err)
+	assert.False(t, called)
+}
+
+func TestNewAuthUnaryServerInterceptor_WhenMethodPublic_DoesNotAuthenticate(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthUnaryServerInterceptor(
+		&authenticator{err: status.Error(codes.Unauthenticated, "no token")},
+		"/grpc.health.v1.Health/Check",
+	)
+
+	resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, ok := domain.PrincipalFromContext(ctx)
+			assert.False(t, ok)
+			return "resp", nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "resp", resp)
+}
+
+func TestNewAuthStreamServerInterceptor_WhenAuthenticated_PlacesPrincipalOnContext(t *testing.T) {
+	t.Parallel()
+	expected := &domain.Principal{Subject: "subject"}
+	interceptor := adapters.NewAuthStreamServerInterceptor(&authenticator{principal: expected})
+
+	var actual *domain.Principal
+	err := interceptor(nil, &serverStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/BeerService/WatchBeers"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			actual, _ = domain.PrincipalFromContext(stream.Context())
+			return nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNewAuthStreamServerInterceptor_WhenNotAuthenticated_ReturnsError(t *testing.T) {
+	t.Parallel()
+	expected := status.Error(codes.Unauthenticated, "no token")
+	interceptor := adapters.NewAuthStreamServerInterceptor(&authenticator{err: expected})
+
+	err := interceptor(nil, &serverStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/BeerService/WatchBeers"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			t.Fatal("handler called")
+			return nil
+		})
+	assert.Equal(t, expected, err)
+}
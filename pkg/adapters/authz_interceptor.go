@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v2"
+)
+
+// methodPermissions maps the BeerService and APIKeyService methods, by full
+// method name, to the permission required to call them.
+var methodPermissions = map[string]domain.Permission{
+	"/BeerService/CreateBeer":       domain.PermissionCreateBeers,
+	"/BeerService/GetBeer":          domain.PermissionGetBeers,
+	"/BeerService/UpdateBeer":       domain.PermissionUpdateBeers,
+	"/BeerService/DeleteBeer":       domain.PermissionDeleteBeers,
+	"/BeerService/UndeleteBeer":     domain.PermissionDeleteBeers,
+	"/BeerService/ListBeers":        domain.PermissionListBeers,
+	"/BeerService/SearchBeers":      domain.PermissionListBeers,
+	"/BeerService/BatchGetBeers":    domain.PermissionGetBeers,
+	"/BeerService/BatchCreateBeers": domain.PermissionCreateBeers,
+	"/BeerService/BatchDeleteBeers": domain.PermissionDeleteBeers,
+	"/BeerService/ListBeerHistory":  domain.PermissionGetBeers,
+	"/BeerService/WatchBeers":       domain.PermissionListBeers,
+	"/BeerService/ExportBeers":      domain.PermissionListBeers,
+	"/BeerService/ImportBeers":      domain.PermissionCreateBeers,
+
+	"/APIKeyService/CreateAPIKey": domain.PermissionCreateAPIKeys,
+	"/APIKeyService/ListAPIKeys":  domain.PermissionListAPIKeys,
+	"/APIKeyService/RevokeAPIKey": domain.PermissionRevokeAPIKeys,
+}
+
+// ParsePolicy parses a YAML policy granting permissions to roles, e.g.
+//
+//	roles:
+//	  viewer: [beers.get, beers.list]
+func ParsePolicy(data []byte) (*domain.Policy, error) {
+	var policy struct {
+		Roles map[string][]domain.Permission `yaml:"roles"`
+	}
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+	return domain.NewPolicy(policy.Roles)
+}
+
+// authorize returns an error if the principal on the context is not granted
+// the permission required to call the method. Methods without a required
+// permission cannot be called by anyone.
+func authorize(ctx context.Context, policy *domain.Policy, method string) error {
+	permission, ok := methodPermissions[method]
+	if !ok {
+		return toError(domain.NewPermissionDeniedError(fmt.Sprintf("method '%s' denied", method)))
+	}
+	principal, _ := domain.PrincipalFromContext(ctx)
+	if err := policy.Authorize(principal, permission); err != nil {
+		return toError(err)
+	}
+	return nil
+}
+
+// NewAuthzUnaryServerInterceptor returns a new unary server interceptor which
+// authorizes the principal on the context to call the method with the policy.
+// The public methods do not require authorization.
+func NewAuthzUnaryServerInterceptor(policy *domain.Policy, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := toSet(publicMethods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !public[info.FullMethod] {
+			if err := authorize(ctx, policy, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewAuthzStreamServerInterceptor returns a new stream server interceptor
+// which authorizes the principal on the stream context to call the method
+// with the policy. The public methods do not require authorization.
+func NewAuthzStreamServerInterceptor(policy *domain.Policy, publicMethods ...string) grpc.StreamServerInterceptor {
+	public := toSet(publicMethods)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !public[info.FullMethod] {
+			if err := authorize(stream.Context(), policy, info.FullMethod); err != nil {
+				return err
+			}
+		}
+		return handler(srv, stream)
+	}
+}
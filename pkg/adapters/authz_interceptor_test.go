@@ -0,0 +1,274 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceMethods returns the full method names of the methods of all the
+// services.
+func serviceMethods() []string {
+	var names []string
+	services := beers.File_api_proto.Services()
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		methods := service.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			names = append(names, fmt.Sprintf("/%s/%s", service.FullName(), methods.Get(j).Name()))
+		}
+	}
+	return names
+}
+
+func TestNewAuthzUnaryServerInterceptor_WithDefaultPolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		method  string
+		allowed []string
+	}{
+		{
+			method:  "/BeerService/CreateBeer",
+			allowed: []string{domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/GetBeer",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/UpdateBeer",
+			allowed: []string{domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/DeleteBeer",
+			allowed: []string{domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/UndeleteBeer",
+			allowed: []string{domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/ListBeers",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/SearchBeers",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/BatchGetBeers",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/BatchCreateBeers",
+			allowed: []string{domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/BatchDeleteBeers",
+			allowed: []string{domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/ListBeerHistory",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/WatchBeers",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/ExportBeers",
+			allowed: []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/BeerService/ImportBeers",
+			allowed: []string{domain.RoleEditor, domain.RoleAdmin},
+		},
+		{
+			method:  "/APIKeyService/CreateAPIKey",
+			allowed: []string{domain.RoleAdmin},
+		},
+		{
+			method:  "/APIKeyService/ListAPIKeys",
+			allowed: []string{domain.RoleAdmin},
+		},
+		{
+			method:  "/APIKeyService/RevokeAPIKey",
+			allowed: []string{domain.RoleAdmin},
+		},
+	}
+
+	var tested []string
+	for _, test := range tests {
+		tested = append(tested, test.method)
+	}
+	assert.ElementsMatch(t, serviceMethods(), tested, "every method must be tested")
+
+	interceptor := adapters.NewAuthzUnaryServerInterceptor(domain.DefaultPolicy())
+	for _, test := range tests {
+		for _, role := range []string{domain.RoleViewer, domain.RoleEditor, domain.RoleAdmin, "unknown"} {
+			t.Run(fmt.Sprintf("test %s %s", role, test.method), func(s *testing.T) {
+				ctx := domain.NewContextWithPrincipal(context.Background(),
+					&domain.Principal{Subject: "subject", Roles: []string{role}})
+				called := false
+				_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: test.method},
+					func(ctx context.Context, req interface{}) (interface{}, error) {
+						called = true
+						return "resp", nil
+					})
+				allowed := false
+				for _, r := range test.allowed {
+					allowed = allowed || r == role
+				}
+				if allowed {
+					assert.Nil(s, err)
+					assert.True(s, called)
+				} else {
+					assert.Equal(s, codes.PermissionDenied, status.Code(err))
+					assert.False(s, called)
+				}
+			})
+		}
+	}
+}
+
+func TestNewAuthzUnaryServerInterceptor_WhenNoPrincipal_ReturnsPermissionDenied(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthzUnaryServerInterceptor(domain.DefaultPolicy())
+	_, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "resp", nil
+		})
+	assert.Equal(t, status.Error(codes.PermissionDenied, "permission 'beers.get' denied"), err)
+}
+
+func TestNewAuthzUnaryServerInterceptor_WhenMethodUnknown_ReturnsPermissionDenied(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthzUnaryServerInterceptor(domain.DefaultPolicy())
+	ctx := domain.NewContextWithPrincipal(context.Background(),
+		&domain.Principal{Subject: "subject", Roles: []string{domain.RoleAdmin}})
+	_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/DrinkBeer"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "resp", nil
+		})
+	assert.Equal(t, status.Error(codes.PermissionDenied, "method '/BeerService/DrinkBeer' denied"), err)
+}
+
+func TestNewAuthzUnaryServerInterceptor_WhenMethodPublic_DoesNotAuthorize(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthzUnaryServerInterceptor(domain.DefaultPolicy(), "/grpc.health.v1.Health/Check")
+	resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "resp", nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "resp", resp)
+}
+
+func TestNewAuthzUnaryServerInterceptor_WhenScopeGranted_CallsHandler(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthzUnaryServerInterceptor(domain.DefaultPolicy())
+	ctx := domain.NewContextWithPrincipal(context.Background(),
+		&domain.Principal{Subject: "apikey:id", Scopes: []domain.Permission{domain.PermissionListBeers}})
+	for method, code := range map[string]codes.Code{
+		"/BeerService/ListBeers": codes.OK,
+		"/BeerService/GetBeer":   codes.PermissionDenied,
+	} {
+		_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "resp", nil
+			})
+		assert.Equal(t, code, status.Code(err), method)
+	}
+}
+
+func TestNewAuthzStreamServerInterceptor_AuthorizesPrincipal(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewAuthzStreamServerInterceptor(domain.DefaultPolicy())
+	tests := []struct {
+		name string
+		role string
+		code codes.Code
+	}{
+		{
+			name: "granted",
+			role: domain.RoleViewer,
+			code: codes.OK,
+		},
+		{
+			name: "denied",
+			role: "unknown",
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			ctx := domain.NewContextWithPrincipal(context.Background(),
+				&domain.Principal{Subject: "subject", Roles: []string{test.role}})
+			err := interceptor(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/BeerService/ListBeers"},
+				func(srv interface{}, stream grpc.ServerStream) error {
+					return nil
+				})
+			assert.Equal(s, test.code, status.Code(err))
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+	policy, err := adapters.ParsePolicy([]byte(`
+roles:
+  reader: [beers.get]
+  deleter:
+    - beers.get
+    - beers.delete
+`))
+	assert.Nil(t, err)
+	reader := &domain.Principal{Subject: "subject", Roles: []string{"reader"}}
+	deleter := &domain.Principal{Subject: "subject", Roles: []string{"deleter"}}
+	assert.Nil(t, policy.Authorize(reader, domain.PermissionGetBeers))
+	assert.NotNil(t, policy.Authorize(reader, domain.PermissionDeleteBeers))
+	assert.Nil(t, policy.Authorize(deleter, domain.PermissionDeleteBeers))
+	assert.NotNil(t, policy.Authorize(&domain.Principal{Roles: []string{domain.RoleAdmin}}, domain.PermissionGetBeers))
+}
+
+func TestParsePolicy_WhenInvalid_ReturnsError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		policy string
+		err    string
+	}{
+		{
+			name:   "not yaml",
+			policy: "roles: [",
+			err:    "invalid policy: yaml: line 1: did not find expected node content",
+		},
+		{
+			name:   "unknown field",
+			policy: "rules: {}",
+			err:    "invalid policy: yaml: unmarshal errors:\n  line 1: field rules not found in type struct { Roles map[string][]domain.Permission \"yaml:\\\"roles\\\"\" }",
+		},
+		{
+			name:   "unknown permission",
+			policy: "roles: {viewer: [beers.drink]}",
+			err:    "unknown permission 'beers.drink' for role 'viewer'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := adapters.ParsePolicy([]byte(test.policy))
+			assert.EqualError(s, err, test.err)
+		})
+	}
+}
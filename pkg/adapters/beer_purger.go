@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Purger permanently removes deleted beers.
+type Purger interface {
+	// PurgeBeers removes the beers deleted for longer than the retention
+	// period and returns the number of beers removed.
+	PurgeBeers(ctx context.Context, retention time.Duration) (int, error)
+}
+
+// NewBeerPurger creates a new beer purger which permanently removes the beers
+// deleted for longer than the retention period.
+func NewBeerPurger(logger *logrus.Logger, purger Purger, retention time.Duration) *BeerPurger {
+	return &BeerPurger{
+		logger:    logger,
+		purger:    purger,
+		retention: retention,
+	}
+}
+
+// BeerPurger periodically purges deleted beers.
+type BeerPurger struct {
+	logger    *logrus.Logger
+	purger    Purger
+	retention time.Duration
+}
+
+// Purge purges the deleted beers once, logging the number of beers purged.
+func (p *BeerPurger) Purge(ctx context.Context) {
+	purged, err := p.purger.PurgeBeers(ctx, p.retention)
+	if err != nil {
+		p.logger.Errorf("failed to purge deleted beers: %v", err)
+		return
+	}
+	if purged > 0 {
+		p.logger.Infof("purged %d beers deleted more than %s ago", purged, p.retention)
+	}
+}
+
+// Run purges the deleted beers every interval until the context is done.
+func (p *BeerPurger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.Purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
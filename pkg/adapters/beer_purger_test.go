@@ -0,0 +1,61 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type purger struct {
+	purged    int
+	err       error
+	retention time.Duration
+}
+
+func (p *purger) PurgeBeers(ctx context.Context, retention time.Duration) (int, error) {
+	p.retention = retention
+	return p.purged, p.err
+}
+
+func TestBeerPurger_Purge_WhenBeersPurged_LogsPurgedBeers(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	p := &purger{purged: 2}
+	adapters.NewBeerPurger(logger, p, time.Hour).Purge(context.Background())
+
+	assert.Equal(t, time.Hour, p.retention)
+	assert.Equal(t, logrus.InfoLevel, hook.LastEntry().Level)
+	assert.Equal(t, "purged 2 beers deleted more than 1h0m0s ago", hook.LastEntry().Message)
+}
+
+func TestBeerPurger_Purge_WhenNoBeersPurged_LogsNothing(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	adapters.NewBeerPurger(logger, &purger{}, time.Hour).Purge(context.Background())
+	assert.Empty(t, hook.AllEntries())
+}
+
+func TestBeerPurger_Purge_WhenPurgeFails_LogsError(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	adapters.NewBeerPurger(logger, &purger{err: errors.New("something went wrong")}, time.Hour).Purge(context.Background())
+
+	assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+	assert.Equal(t, "failed to purge deleted beers: something went wrong", hook.LastEntry().Message)
+}
+
+func TestBeerPurger_Run_WhenContextDone_Returns(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	adapters.NewBeerPurger(logger, &purger{purged: 1}, time.Hour).Run(ctx, time.Hour)
+	assert.Len(t, hook.AllEntries(), 1)
+}
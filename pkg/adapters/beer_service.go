@@ -3,13 +3,19 @@ package adapters
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 	"github.com/bvwells/grpc-gateway-example/proto/beers"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -23,28 +29,42 @@ type BeerInteractor interface {
 	UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error)
 	// DeleteBeer deletes a beers.
 	DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams) error
-	// ListBeers lists beers.
-	ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error)
+	// UndeleteBeer undeletes a deleted beer.
+	UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams) (*domain.Beer, error)
+	// ListBeers lists a page of beers.
+	ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error)
+	// SearchBeers searches a page of beers.
+	SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error)
+	// BatchGetBeers gets a batch of beers.
+	BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error)
+	// BatchCreateBeers creates a batch of beers.
+	BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams) ([]*domain.Beer, error)
+	// BatchDeleteBeers deletes a batch of beers.
+	BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams) error
+	// ListBeerHistory lists the audit entries of a beer.
+	ListBeerHistory(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error)
+	// WatchBeers calls the handler with the events of beers.
+	WatchBeers(ctx context.Context, params *domain.WatchBeersParams, handler func(*domain.BeerEvent) error) error
+	// ExportBeers calls the handler with every beer.
+	ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error
+	// ImportBeers imports a batch of beers.
+	ImportBeers(ctx context.Context, params *domain.ImportBeersParams) (*domain.ImportBeersResult, error)
 }
 
 // NewBeerService creates a new beer service.
-func NewBeerService(interactor BeerInteractor) *BeerService {
-	return &BeerService{interactor: interactor}
+func NewBeerService(interactor BeerInteractor, pageTokens *PageTokenCodec) *BeerService {
+	return &BeerService{interactor: interactor, pageTokens: pageTokens}
 }
 
 // BeerService implements the BeerService service gRPC API.
 type BeerService struct {
 	interactor BeerInteractor
+	pageTokens *PageTokenCodec
 }
 
 // CreateBeer create a beer with specified beer parameters.
 func (svc *BeerService) CreateBeer(ctx context.Context, params *beers.CreateBeerRequest) (*beers.Beer, error) {
-	item, err := svc.interactor.CreateBeer(ctx, &domain.CreateBeerParams{
-		Name:    params.Name,
-		Type:    fromProtoType(params.Type),
-		Brewer:  params.Brewer,
-		Country: params.Country,
-	})
+	item, err := svc.interactor.CreateBeer(ctx, fromProtoCreateBeerRequest(params))
 	if err != nil {
 		return nil, toError(err)
 	}
@@ -53,7 +73,7 @@ func (svc *BeerService) CreateBeer(ctx context.Context, params *beers.CreateBeer
 
 // GetBeer gets the beer with specified beer identifier.
 func (svc *BeerService) GetBeer(ctx context.Context, params *beers.GetBeerRequest) (*beers.Beer, error) {
-	item, err := svc.interactor.GetBeer(ctx, &domain.GetBeerParams{ID: params.Id})
+	item, err := svc.interactor.GetBeer(ctx, &domain.GetBeerParams{ID: params.Id, ShowDeleted: params.ShowDeleted})
 	if err != nil {
 		return nil, toError(err)
 	}
@@ -65,9 +85,21 @@ func (svc *BeerService) UpdateBeer(ctx context.Context, params *beers.UpdateBeer
 	if params.UpdateMask == nil {
 		return nil, status.Error(codes.InvalidArgument, "no fields specified")
 	}
+	if params.Beer == nil {
+		return nil, status.Error(codes.InvalidArgument, "beer is required")
+	}
 
+	etag := params.Etag
+	if etag == "" {
+		etag = params.Beer.Etag
+	}
+	version, err := versionFromETag(ctx, etag)
+	if err != nil {
+		return nil, toError(err)
+	}
 	updateParams := &domain.UpdateBeerParams{
-		ID: params.Beer.Id,
+		ID:      params.Beer.Id,
+		Version: version,
 	}
 	for _, path := range params.UpdateMask.Paths {
 		switch field := strings.ToLower(path); field {
@@ -80,6 +112,20 @@ func (svc *BeerService) UpdateBeer(ctx context.Context, params *beers.UpdateBeer
 			updateParams.Brewer = &params.Beer.Brewer
 		case "country":
 			updateParams.Country = &params.Beer.Country
+		case "abv":
+			updateParams.ABV = &params.Beer.Abv
+		case "ibu":
+			ibu := int(params.Beer.Ibu)
+			updateParams.IBU = &ibu
+		case "srm":
+			updateParams.SRM = &params.Beer.Srm
+		case "volume_ml":
+			volumeML := int(params.Beer.VolumeMl)
+			updateParams.VolumeML = &volumeML
+		case "description":
+			updateParams.Description = &params.Beer.Description
+		case "etag":
+			// The etag is read only, it is used as the precondition above.
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid beer field: %s", field)
 		}
@@ -94,38 +140,429 @@ func (svc *BeerService) UpdateBeer(ctx context.Context, params *beers.UpdateBeer
 
 // DeleteBeer deletes the beer with specified beer identifier.
 func (svc *BeerService) DeleteBeer(ctx context.Context, params *beers.DeleteBeerRequest) (*empty.Empty, error) {
-	err := svc.interactor.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: params.Id})
+	version, err := versionFromETag(ctx, params.Etag)
+	if err != nil {
+		return nil, toError(err)
+	}
+	err = svc.interactor.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: params.Id, Version: version})
 	if err != nil {
 		return nil, toError(err)
 	}
 	return &empty.Empty{}, nil
 }
 
-// ListBeers lists all beers.
+// UndeleteBeer undeletes the deleted beer with specified beer identifier.
+func (svc *BeerService) UndeleteBeer(ctx context.Context, params *beers.UndeleteBeerRequest) (*beers.Beer, error) {
+	version, err := versionFromETag(ctx, params.Etag)
+	if err != nil {
+		return nil, toError(err)
+	}
+	item, err := svc.interactor.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: params.Id, Version: version})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return toProtoBeer(item), nil
+}
+
+// ListBeers lists a page of beers.
 func (svc *BeerService) ListBeers(ctx context.Context, params *beers.ListBeersRequest) (*beers.ListBeersResponse, error) {
-	items, err := svc.interactor.ListBeers(ctx, &domain.ListBeersParams{
-		Page: int(params.Page),
-	})
+	listParams := &domain.ListBeersParams{
+		PageSize:    int(params.PageSize),
+		Brewer:      params.Brewer,
+		Country:     params.Country,
+		NamePrefix:  params.NamePrefix,
+		OrderBy:     params.OrderBy,
+		ShowDeleted: params.ShowDeleted,
+	}
+	if params.Type != beers.BeerType_BEER_TYPE_UNSPECIFIED {
+		beerType := fromProtoType(params.Type)
+		listParams.Type = &beerType
+	}
+
+	requestKey := listBeersRequestKey(listParams)
+	if params.PageToken != "" {
+		after, err := svc.pageTokens.Decode(params.PageToken, requestKey)
+		if err != nil {
+			return nil, toError(err)
+		}
+		listParams.After = after
+	}
+
+	result, err := svc.interactor.ListBeers(ctx, listParams)
 	if err != nil {
 		return nil, toError(err)
 	}
 	b := &beers.ListBeersResponse{
-		Beers: make([]*beers.Beer, 0, len(items)),
+		Beers:     make([]*beers.Beer, 0, len(result.Beers)),
+		TotalSize: int32(result.TotalSize),
 	}
-	for _, item := range items {
+	for _, item := range result.Beers {
 		b.Beers = append(b.Beers, toProtoBeer(item))
 	}
+	if result.Next != nil {
+		b.NextPageToken, err = svc.pageTokens.Encode(result.Next, requestKey)
+		if err != nil {
+			return nil, toError(err)
+		}
+	}
+	return b, nil
+}
+
+// SearchBeers searches a page of beers.
+func (svc *BeerService) SearchBeers(ctx context.Context, params *beers.SearchBeersRequest) (*beers.SearchBeersResponse, error) {
+	searchParams := &domain.SearchBeersParams{
+		Query:    params.Q,
+		PageSize: int(params.PageSize),
+	}
+
+	requestKey := searchBeersRequestKey(searchParams)
+	if params.PageToken != "" {
+		after, err := svc.pageTokens.Decode(params.PageToken, requestKey)
+		if err != nil {
+			return nil, toError(err)
+		}
+		searchParams.After = after
+	}
+
+	result, err := svc.interactor.SearchBeers(ctx, searchParams)
+	if err != nil {
+		return nil, toError(err)
+	}
+	b := &beers.SearchBeersResponse{
+		Results: make([]*beers.SearchResult, 0, len(result.Results)),
+	}
+	for _, item := range result.Results {
+		b.Results = append(b.Results, toProtoSearchResult(item))
+	}
+	if result.Next != nil {
+		b.NextPageToken, err = svc.pageTokens.Encode(result.Next, requestKey)
+		if err != nil {
+			return nil, toError(err)
+		}
+	}
+	return b, nil
+}
+
+// BatchGetBeers gets the beers with specified beer identifiers.
+func (svc *BeerService) BatchGetBeers(ctx context.Context, params *beers.BatchGetBeersRequest) (*beers.BatchGetBeersResponse, error) {
+	items, err := svc.interactor.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: params.Ids})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &beers.BatchGetBeersResponse{Beers: toProtoBeers(items)}, nil
+}
+
+// BatchCreateBeers creates a beer for each of the specified create requests.
+func (svc *BeerService) BatchCreateBeers(ctx context.Context, params *beers.BatchCreateBeersRequest) (*beers.BatchCreateBeersResponse, error) {
+	createParams := &domain.BatchCreateBeersParams{
+		Beers: make([]*domain.CreateBeerParams, 0, len(params.Requests)),
+	}
+	for _, req := range params.Requests {
+		createParams.Beers = append(createParams.Beers, fromProtoCreateBeerRequest(req))
+	}
+	items, err := svc.interactor.BatchCreateBeers(ctx, createParams)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &beers.BatchCreateBeersResponse{Beers: toProtoBeers(items)}, nil
+}
+
+// BatchDeleteBeers deletes the beers of the specified delete requests.
+func (svc *BeerService) BatchDeleteBeers(ctx context.Context, params *beers.BatchDeleteBeersRequest) (*empty.Empty, error) {
+	deleteParams := &domain.BatchDeleteBeersParams{
+		Beers: make([]*domain.DeleteBeerParams, 0, len(params.Requests)),
+	}
+	for i, req := range params.Requests {
+		version, err := parseETag(req.Etag)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: %v", i, err)
+		}
+		deleteParams.Beers = append(deleteParams.Beers, &domain.DeleteBeerParams{ID: req.Id, Version: version})
+	}
+	err := svc.interactor.BatchDeleteBeers(ctx, deleteParams)
+	if err != nil {
+		return nil, toError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListBeerHistory lists the audit entries of the beer with specified beer
+// identifier.
+func (svc *BeerService) ListBeerHistory(ctx context.Context, params *beers.ListBeerHistoryRequest) (*beers.ListBeerHistoryResponse, error) {
+	entries, err := svc.interactor.ListBeerHistory(ctx, &domain.ListBeerHistoryParams{ID: params.Id})
+	if err != nil {
+		return nil, toError(err)
+	}
+	b := &beers.ListBeerHistoryResponse{
+		Entries: make([]*beers.BeerAuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		b.Entries = append(b.Entries, toProtoAuditEntry(entry))
+	}
 	return b, nil
 }
 
+// WatchBeers streams the events of beers until the caller cancels the call.
+func (svc *BeerService) WatchBeers(params *beers.WatchBeersRequest, stream beers.BeerService_WatchBeersServer) error {
+	err := svc.interactor.WatchBeers(stream.Context(), &domain.WatchBeersParams{ResumeToken: params.ResumeToken},
+		func(event *domain.BeerEvent) error {
+			return stream.Send(toProtoBeerEvent(event))
+		})
+	if err != nil {
+		return toError(err)
+	}
+	return nil
+}
+
+// ExportBeers streams every beer.
+func (svc *BeerService) ExportBeers(params *beers.ExportBeersRequest, stream beers.BeerService_ExportBeersServer) error {
+	err := svc.interactor.ExportBeers(stream.Context(), &domain.ExportBeersParams{ShowDeleted: params.ShowDeleted},
+		func(beer *domain.Beer) error {
+			return stream.Send(toProtoBeer(beer))
+		})
+	if err != nil {
+		return toError(err)
+	}
+	return nil
+}
+
+// ImportBeers imports the beers streamed by the caller in batches, each
+// imported as soon as it is full, and returns a summary of the import once
+// the caller closes the stream. Beers which cannot be read fail without
+// failing the rest of the import. Batches imported before an error remain
+// imported.
+func (svc *BeerService) ImportBeers(stream beers.BeerService_ImportBeersServer) error {
+	var (
+		result = &domain.ImportBeersResult{}
+		batch  []*domain.Beer
+		// indices are the indices in the import of the beers of the batch.
+		indices []int
+		index   int
+	)
+	flush := func() error {
+		imported, err := svc.interactor.ImportBeers(stream.Context(), &domain.ImportBeersParams{Beers: batch})
+		if err != nil {
+			return toError(err)
+		}
+		result.Add(imported, indices)
+		batch, indices = nil, nil
+		return nil
+	}
+
+	for {
+		params, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, in := range params.Beers {
+			beer, err := fromProtoBeer(in)
+			if err != nil {
+				result.Fail(index, in.Id, err)
+				index++
+				continue
+			}
+			batch = append(batch, beer)
+			indices = append(indices, index)
+			index++
+			if len(batch) == domain.ImportBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	sort.Slice(result.Errors, func(i, j int) bool { return result.Errors[i].Index < result.Errors[j].Index })
+	return stream.SendAndClose(toProtoImportBeersResponse(result))
+}
+
+func fromProtoCreateBeerRequest(in *beers.CreateBeerRequest) *domain.CreateBeerParams {
+	return &domain.CreateBeerParams{
+		Name:        in.Name,
+		Type:        fromProtoType(in.Type),
+		Brewer:      in.Brewer,
+		Country:     in.Country,
+		ABV:         in.Abv,
+		IBU:         int(in.Ibu),
+		SRM:         in.Srm,
+		VolumeML:    int(in.VolumeMl),
+		Description: in.Description,
+	}
+}
+
 func toProtoBeer(in *domain.Beer) *beers.Beer {
-	return &beers.Beer{
-		Id:      in.ID,
-		Name:    in.Name,
-		Type:    toProtoType(in.Type),
-		Brewer:  in.Brewer,
-		Country: in.Country,
+	out := &beers.Beer{
+		Id:          in.ID,
+		Name:        in.Name,
+		Type:        toProtoType(in.Type),
+		Brewer:      in.Brewer,
+		Country:     in.Country,
+		Abv:         in.ABV,
+		Ibu:         int32(in.IBU),
+		Srm:         in.SRM,
+		VolumeMl:    int32(in.VolumeML),
+		Description: in.Description,
+		Etag:        strconv.FormatInt(in.Version, 10),
+	}
+	if in.DeleteTime != nil {
+		out.DeleteTime = toProtoTimestamp(*in.DeleteTime)
+	}
+	return out
+}
+
+// fromProtoBeer converts a beer to import, ignoring its etag as beers are
+// imported with version one.
+func fromProtoBeer(in *beers.Beer) (*domain.Beer, error) {
+	out := &domain.Beer{
+		ID:          in.Id,
+		Name:        in.Name,
+		Type:        fromProtoType(in.Type),
+		Brewer:      in.Brewer,
+		Country:     in.Country,
+		ABV:         in.Abv,
+		IBU:         int(in.Ibu),
+		SRM:         in.Srm,
+		VolumeML:    int(in.VolumeMl),
+		Description: in.Description,
+	}
+	if in.DeleteTime != nil {
+		deleteTime, err := ptypes.Timestamp(in.DeleteTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delete time: %w", err)
+		}
+		out.DeleteTime = &deleteTime
+	}
+	return out, nil
+}
+
+func toProtoImportBeersResponse(in *domain.ImportBeersResult) *beers.ImportBeersResponse {
+	out := &beers.ImportBeersResponse{
+		CreatedCount: int32(in.Created),
+		SkippedCount: int32(in.Skipped),
+		FailedCount:  int32(in.Failed),
+		Errors:       make([]*beers.ImportError, 0, len(in.Errors)),
+	}
+	for _, e := range in.Errors {
+		out.Errors = append(out.Errors, &beers.ImportError{
+			Index:   int32(e.Index),
+			Id:      e.ID,
+			Message: e.Message,
+		})
+	}
+	return out
+}
+
+func toProtoSearchResult(in *domain.SearchResult) *beers.SearchResult {
+	out := &beers.SearchResult{
+		Beer:     toProtoBeer(in.Beer),
+		Score:    in.Score,
+		Snippets: make([]*beers.Snippet, 0, len(in.Snippets)),
+	}
+	for _, snippet := range in.Snippets {
+		out.Snippets = append(out.Snippets, &beers.Snippet{Field: snippet.Field, Text: snippet.Text})
+	}
+	return out
+}
+
+func toProtoBeers(in []*domain.Beer) []*beers.Beer {
+	out := make([]*beers.Beer, 0, len(in))
+	for _, beer := range in {
+		out = append(out, toProtoBeer(beer))
+	}
+	return out
+}
+
+// versionFromETag returns the version of the beer the etag was returned for,
+// or nil if there is no etag or the etag is "*", which matches any version.
+// Without an etag in the request the etag in the if-match metadata, forwarded
+// from the If-Match header by the gateway, is used. Etags may be quoted as in
+// the ETag header.
+func versionFromETag(ctx context.Context, etag string) (*int64, error) {
+	if etag == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if values := md.Get("if-match"); len(values) > 0 {
+			etag = strings.TrimSpace(values[0])
+		}
+	}
+	return parseETag(etag)
+}
+
+// parseETag returns the version of the beer the etag was returned for, or nil
+// if the etag is empty or "*".
+func parseETag(etag string) (*int64, error) {
+	if len(etag) >= 2 && strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`) {
+		etag = etag[1 : len(etag)-1]
+	}
+	if etag == "" || etag == "*" {
+		return nil, nil
+	}
+	version, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || version < 1 {
+		return nil, domain.NewValidationError(fmt.Sprintf("invalid etag '%s'", etag))
+	}
+	return &version, nil
+}
+
+func toProtoBeerEvent(in *domain.BeerEvent) *beers.BeerEvent {
+	return &beers.BeerEvent{
+		Type:        toProtoBeerEventType(in.Type),
+		Beer:        toProtoBeer(in.Beer),
+		CreateTime:  toProtoTimestamp(in.CreateTime),
+		ResumeToken: in.ResumeToken,
+	}
+}
+
+func toProtoBeerEventType(in domain.BeerEventType) beers.BeerEventType {
+	switch in {
+	case domain.BeerCreated:
+		return beers.BeerEventType_BEER_EVENT_TYPE_CREATED
+	case domain.BeerUpdated:
+		return beers.BeerEventType_BEER_EVENT_TYPE_UPDATED
+	case domain.BeerDeleted:
+		return beers.BeerEventType_BEER_EVENT_TYPE_DELETED
+	}
+	return beers.BeerEventType_BEER_EVENT_TYPE_UNSPECIFIED
+}
+
+func toProtoAuditEntry(in *domain.AuditEntry) *beers.BeerAuditEntry {
+	out := &beers.BeerAuditEntry{
+		Id:         in.ID,
+		BeerId:     in.BeerID,
+		Action:     toProtoAuditAction(in.Action),
+		Actor:      in.Actor,
+		RequestId:  in.RequestID,
+		Changes:    make([]*beers.FieldChange, 0, len(in.Changes)),
+		CreateTime: toProtoTimestamp(in.CreateTime),
+	}
+	for _, change := range in.Changes {
+		out.Changes = append(out.Changes, &beers.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+	return out
+}
+
+func toProtoAuditAction(in domain.AuditAction) beers.AuditAction {
+	switch in {
+	case domain.AuditActionCreate:
+		return beers.AuditAction_AUDIT_ACTION_CREATE
+	case domain.AuditActionUpdate:
+		return beers.AuditAction_AUDIT_ACTION_UPDATE
+	case domain.AuditActionDelete:
+		return beers.AuditAction_AUDIT_ACTION_DELETE
+	case domain.AuditActionUndelete:
+		return beers.AuditAction_AUDIT_ACTION_UNDELETE
 	}
+	return beers.AuditAction_AUDIT_ACTION_UNSPECIFIED
 }
 
 func toProtoType(in domain.BeerType) beers.BeerType {
@@ -177,8 +614,27 @@ func fromProtoType(in beers.BeerType) domain.BeerType {
 }
 
 func toError(err error) error {
-	if errors.As(err, &domain.ValidationError{}) {
+	switch {
+	case errors.As(err, &domain.ValidationError{}):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &domain.NotFoundError{}):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &domain.AlreadyExistsError{}):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.As(err, &domain.ConflictError{}):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.As(err, &domain.FailedPreconditionError{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &domain.PermissionDeniedError{}):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &domain.UnauthenticatedError{}):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.As(err, &domain.ResourceExhaustedError{}):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
 	}
 	return status.Error(codes.Internal, err.Error())
 }
@@ -3,31 +3,41 @@ package adapters_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
 	"github.com/bvwells/grpc-gateway-example/pkg/adapters/mocks"
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 	"github.com/bvwells/grpc-gateway-example/proto/beers"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 //go:generate mockery -name=BeerInteractor -case=underscore
 
+var pageTokens = adapters.NewPageTokenCodec([]byte("secret"))
+
 func TestNewBeerService_ReturnsBeerInteractor(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	assert.NotNil(t, adapters.NewBeerService(interactor))
+	assert.NotNil(t, adapters.NewBeerService(interactor, pageTokens))
 }
 
 func TestCreateBeer_WhenCreateBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.CreateBeerRequest{
 		Name:    "a beer",
@@ -50,7 +60,7 @@ func TestCreateBeer_WhenCreateBeerReturnsError_ReturnsError(t *testing.T) {
 func TestCreateBeer_WhenCreateBeerReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.CreateBeerRequest{
 		Name:    "a beer",
@@ -73,32 +83,54 @@ func TestCreateBeer_WhenCreateBeerReturnsValidationError_ReturnsInvalidArgumentE
 func TestCreateBeer_WhenCreateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	expected := &beers.Beer{
-		Id:      "id",
-		Name:    "a beer",
-		Type:    beers.BeerType_BEER_TYPE_LAGER,
-		Brewer:  "brewer",
-		Country: "country",
+		Id:          "id",
+		Name:        "a beer",
+		Type:        beers.BeerType_BEER_TYPE_LAGER,
+		Brewer:      "brewer",
+		Country:     "country",
+		Abv:         4.8,
+		Ibu:         30,
+		Srm:         3.5,
+		VolumeMl:    330,
+		Description: "crisp",
+		Etag:        "3",
 	}
 	params := &beers.CreateBeerRequest{
-		Name:    "a beer",
-		Type:    beers.BeerType_BEER_TYPE_LAGER,
-		Brewer:  "brewer",
-		Country: "country",
+		Name:        "a beer",
+		Type:        beers.BeerType_BEER_TYPE_LAGER,
+		Brewer:      "brewer",
+		Country:     "country",
+		Abv:         4.8,
+		Ibu:         30,
+		Srm:         3.5,
+		VolumeMl:    330,
+		Description: "crisp",
 	}
 	interactor.On("CreateBeer", ctx, &domain.CreateBeerParams{
-		Name:    expected.Name,
-		Type:    domain.Lager,
-		Brewer:  expected.Brewer,
-		Country: expected.Country,
+		Name:        expected.Name,
+		Type:        domain.Lager,
+		Brewer:      expected.Brewer,
+		Country:     expected.Country,
+		ABV:         4.8,
+		IBU:         30,
+		SRM:         3.5,
+		VolumeML:    330,
+		Description: "crisp",
 	}).Return(&domain.Beer{
-		ID:      expected.Id,
-		Name:    expected.Name,
-		Type:    domain.Lager,
-		Brewer:  expected.Brewer,
-		Country: expected.Country,
+		ID:          expected.Id,
+		Name:        expected.Name,
+		Type:        domain.Lager,
+		Brewer:      expected.Brewer,
+		Country:     expected.Country,
+		ABV:         4.8,
+		IBU:         30,
+		SRM:         3.5,
+		VolumeML:    330,
+		Description: "crisp",
+		Version:     3,
 	}, nil)
 	actual, _ := service.CreateBeer(ctx, params)
 	assert.Equal(t, expected, actual)
@@ -107,7 +139,7 @@ func TestCreateBeer_WhenCreateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 func TestGetBeer_WhenGetBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.GetBeerRequest{Id: "ID"}
 
@@ -121,7 +153,7 @@ func TestGetBeer_WhenGetBeerReturnsError_ReturnsError(t *testing.T) {
 func TestGetBeer_WhenGetBeerReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.GetBeerRequest{Id: "ID"}
 
@@ -135,7 +167,7 @@ func TestGetBeer_WhenGetBeerReturnsValidationError_ReturnsInvalidArgumentError(t
 func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.GetBeerRequest{Id: "ID"}
 	expected := &beers.Beer{
@@ -144,6 +176,7 @@ func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 		Type:    beers.BeerType_BEER_TYPE_INDIA_PALE_ALE,
 		Brewer:  "brewer",
 		Country: "country",
+		Etag:    "3",
 	}
 	interactor.On("GetBeer", ctx, &domain.GetBeerParams{ID: params.Id}).Return(&domain.Beer{
 		ID:      expected.Id,
@@ -151,6 +184,7 @@ func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 		Type:    domain.IndiaPaleAle,
 		Brewer:  expected.Brewer,
 		Country: expected.Country,
+		Version: 3,
 	}, nil)
 	actual, _ := service.GetBeer(ctx, params)
 	assert.Equal(t, expected, actual)
@@ -159,7 +193,7 @@ func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 func TestUpdateBeer_WhenFieldMaskNotSpecified_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.UpdateBeerRequest{
 		Beer: &beers.Beer{Id: "id", Name: "name"},
@@ -171,10 +205,21 @@ func TestUpdateBeer_WhenFieldMaskNotSpecified_ReturnsError(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, st.Code())
 }
 
+func TestUpdateBeer_WhenBeerNotSpecified_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	params := &beers.UpdateBeerRequest{
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+	}
+	_, err := service.UpdateBeer(context.Background(), params)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "beer is required"), err)
+}
+
 func TestUpdateBeer_WhenFieldMaskContainsInvalidField_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.UpdateBeerRequest{
 		Beer:       &beers.Beer{Id: "id", Name: "name"},
@@ -190,7 +235,7 @@ func TestUpdateBeer_WhenFieldMaskContainsInvalidField_ReturnsError(t *testing.T)
 func TestUpdateBeer_WhenUpdateBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.UpdateBeerRequest{
 		Beer:       &beers.Beer{Id: "id", Name: "name"},
@@ -206,7 +251,7 @@ func TestUpdateBeer_WhenUpdateBeerReturnsError_ReturnsError(t *testing.T) {
 func TestUpdateBeer_WhenUpdateBeerReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.UpdateBeerRequest{
 		Beer:       &beers.Beer{Id: "id", Name: "name"},
@@ -222,7 +267,7 @@ func TestUpdateBeer_WhenUpdateBeerReturnsValidationError_ReturnsInvalidArgumentE
 func TestUpdateBeer_WhenUpdateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.UpdateBeerRequest{
 		Beer:       &beers.Beer{Id: "id", Name: "name", Type: beers.BeerType_BEER_TYPE_STOUT, Brewer: "brewer", Country: "Country"},
@@ -234,6 +279,7 @@ func TestUpdateBeer_WhenUpdateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 		Type:    beers.BeerType_BEER_TYPE_STOUT,
 		Brewer:  "brewer",
 		Country: "country",
+		Etag:    "3",
 	}
 	beerType := domain.Stout
 	interactor.On("UpdateBeer", ctx, &domain.UpdateBeerParams{
@@ -248,15 +294,61 @@ func TestUpdateBeer_WhenUpdateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 		Type:    domain.Stout,
 		Brewer:  expected.Brewer,
 		Country: expected.Country,
+		Version: 3,
 	}, nil)
 	actual, _ := service.UpdateBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
 
+func TestUpdateBeer_WhenFieldMaskContainsAttributes_UpdatesAttributes(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	params := &beers.UpdateBeerRequest{
+		Beer: &beers.Beer{
+			Id:          "id",
+			Name:        "name",
+			Abv:         5.2,
+			Ibu:         45,
+			Srm:         12,
+			VolumeMl:    500,
+			Description: "hoppy",
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"abv", "IBU", "srm", "volume_ml", "description"}},
+	}
+	ibu, volumeML := 45, 500
+	beer := &domain.Beer{
+		ID:          "id",
+		Name:        "a beer",
+		ABV:         5.2,
+		IBU:         45,
+		SRM:         12,
+		VolumeML:    500,
+		Description: "hoppy",
+		Version:     3,
+	}
+	interactor.On("UpdateBeer", ctx, &domain.UpdateBeerParams{
+		ID:          params.Beer.Id,
+		ABV:         &params.Beer.Abv,
+		IBU:         &ibu,
+		SRM:         &params.Beer.Srm,
+		VolumeML:    &volumeML,
+		Description: &params.Beer.Description,
+	}).Return(beer, nil)
+	actual, err := service.UpdateBeer(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, 5.2, actual.Abv)
+	assert.Equal(t, int32(45), actual.Ibu)
+	assert.Equal(t, 12.0, actual.Srm)
+	assert.Equal(t, int32(500), actual.VolumeMl)
+	assert.Equal(t, "hoppy", actual.Description)
+}
+
 func TestDeleteBeer_WhenDeleteBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.DeleteBeerRequest{Id: "id"}
 	const msg = "something went wrong"
@@ -269,7 +361,7 @@ func TestDeleteBeer_WhenDeleteBeerReturnsError_ReturnsError(t *testing.T) {
 func TestDeleteBeer_WhenDeleteBeerReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.DeleteBeerRequest{Id: "id"}
 	const msg = "something went wrong"
@@ -282,7 +374,7 @@ func TestDeleteBeer_WhenDeleteBeerReturnsValidationError_ReturnsInvalidArgumentE
 func TestDeleteBeer_WhenDeleteBeerReturnsNil_ReturnsNilError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	params := &beers.DeleteBeerRequest{Id: "id"}
 	interactor.On("DeleteBeer", ctx, &domain.DeleteBeerParams{ID: params.Id}).Return(nil)
@@ -290,49 +382,860 @@ func TestDeleteBeer_WhenDeleteBeerReturnsNil_ReturnsNilError(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
+func TestGetBeer_WhenShowDeleted_ReturnsDeletedBeer(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	deleteTime := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	interactor.On("GetBeer", ctx, &domain.GetBeerParams{ID: "id", ShowDeleted: true}).
+		Return(&domain.Beer{ID: "id", Version: 2, DeleteTime: &deleteTime}, nil)
+	actual, err := service.GetBeer(ctx, &beers.GetBeerRequest{Id: "id", ShowDeleted: true})
+	assert.Nil(t, err)
+	expected, _ := ptypes.TimestampProto(deleteTime)
+	assert.Equal(t, &beers.Beer{Id: "id", Etag: "2", DeleteTime: expected}, actual)
+}
+
+func TestUndeleteBeer_WhenUndeleteBeerReturnsBeer_ReturnsBeer(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"2"`))
+	version := int64(2)
+	interactor.On("UndeleteBeer", ctx, &domain.UndeleteBeerParams{ID: "id", Version: &version}).
+		Return(&domain.Beer{ID: "id", Name: "name", Version: 3}, nil)
+	actual, err := service.UndeleteBeer(ctx, &beers.UndeleteBeerRequest{Id: "id"})
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.Beer{Id: "id", Name: "name", Etag: "3"}, actual)
+}
+
+func TestUndeleteBeer_WhenUndeleteBeerReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("UndeleteBeer", ctx, &domain.UndeleteBeerParams{ID: "id"}).
+		Return(nil, domain.NewFailedPreconditionError("beer 'id' is not deleted"))
+	_, actual := service.UndeleteBeer(ctx, &beers.UndeleteBeerRequest{Id: "id"})
+	assert.Equal(t, status.Error(codes.FailedPrecondition, "beer 'id' is not deleted"), actual)
+}
+
+func TestUndeleteBeer_WhenETagInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	_, actual := service.UndeleteBeer(context.Background(), &beers.UndeleteBeerRequest{Id: "id", Etag: "etag"})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "invalid etag 'etag'"), actual)
+	interactor.AssertNotCalled(t, "UndeleteBeer")
+}
+
+func TestListBeerHistory_WhenListBeerHistoryReturnsEntries_ReturnsEntries(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	createTime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	expectedCreateTime, _ := ptypes.TimestampProto(createTime)
+	interactor.On("ListBeerHistory", ctx, &domain.ListBeerHistoryParams{ID: "id"}).
+		Return([]*domain.AuditEntry{
+			{
+				ID:         "entry",
+				BeerID:     "id",
+				Action:     domain.AuditActionUpdate,
+				Actor:      "subject",
+				RequestID:  "request",
+				Changes:    []domain.FieldChange{{Field: "name", Before: "before", After: "after"}},
+				CreateTime: createTime,
+			},
+			{ID: "another entry", BeerID: "id", Action: domain.AuditActionDelete, CreateTime: createTime},
+		}, nil)
+	actual, err := service.ListBeerHistory(ctx, &beers.ListBeerHistoryRequest{Id: "id"})
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.ListBeerHistoryResponse{
+		Entries: []*beers.BeerAuditEntry{
+			{
+				Id:         "entry",
+				BeerId:     "id",
+				Action:     beers.AuditAction_AUDIT_ACTION_UPDATE,
+				Actor:      "subject",
+				RequestId:  "request",
+				Changes:    []*beers.FieldChange{{Field: "name", Before: "before", After: "after"}},
+				CreateTime: expectedCreateTime,
+			},
+			{
+				Id:         "another entry",
+				BeerId:     "id",
+				Action:     beers.AuditAction_AUDIT_ACTION_DELETE,
+				Changes:    []*beers.FieldChange{},
+				CreateTime: expectedCreateTime,
+			},
+		},
+	}, actual)
+}
+
+func TestListBeerHistory_WhenListBeerHistoryReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("ListBeerHistory", ctx, &domain.ListBeerHistoryParams{}).
+		Return(nil, domain.NewValidationError("beer ID is empty"))
+	_, actual := service.ListBeerHistory(ctx, &beers.ListBeerHistoryRequest{})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "beer ID is empty"), actual)
+}
+
+func TestUpdateBeer_WhenETagSpecified_PassesVersionToInteractor(t *testing.T) {
+	t.Parallel()
+	version := int64(7)
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		etag    string
+		beer    string
+		version *int64
+	}{
+		{
+			name: "no etag",
+			ctx:  context.Background(),
+		},
+		{
+			name:    "etag",
+			ctx:     context.Background(),
+			etag:    "7",
+			version: &version,
+		},
+		{
+			name:    "quoted etag",
+			ctx:     context.Background(),
+			etag:    `"7"`,
+			version: &version,
+		},
+		{
+			name:    "if-match",
+			ctx:     metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"7"`)),
+			version: &version,
+		},
+		{
+			name:    "etag and if-match",
+			ctx:     metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"8"`)),
+			etag:    "7",
+			version: &version,
+		},
+		{
+			name:    "beer etag",
+			ctx:     metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"8"`)),
+			beer:    "7",
+			version: &version,
+		},
+		{
+			name: "if-match any",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", "*")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			interactor := &mocks.BeerInteractor{}
+			service := adapters.NewBeerService(interactor, pageTokens)
+			name := "name"
+			interactor.On("UpdateBeer", test.ctx, &domain.UpdateBeerParams{
+				ID:      "id",
+				Name:    &name,
+				Version: test.version,
+			}).Return(&domain.Beer{ID: "id", Name: name, Version: 8}, nil)
+			actual, err := service.UpdateBeer(test.ctx, &beers.UpdateBeerRequest{
+				Beer:       &beers.Beer{Id: "id", Name: name, Etag: test.beer},
+				UpdateMask: &field_mask.FieldMask{Paths: []string{"name", "etag"}},
+				Etag:       test.etag,
+			})
+			assert.Nil(s, err)
+			assert.Equal(s, &beers.Beer{Id: "id", Name: name, Etag: "8"}, actual)
+		})
+	}
+}
+
+func TestUpdateBeer_WhenETagInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		etag     string
+		expected error
+	}{
+		{
+			name:     "not a number",
+			ctx:      context.Background(),
+			etag:     "abc",
+			expected: status.Error(codes.InvalidArgument, "invalid etag 'abc'"),
+		},
+		{
+			name:     "zero",
+			ctx:      context.Background(),
+			etag:     "0",
+			expected: status.Error(codes.InvalidArgument, "invalid etag '0'"),
+		},
+		{
+			name:     "weak if-match",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `W/"1"`)),
+			expected: status.Error(codes.InvalidArgument, `invalid etag 'W/"1"'`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			interactor := &mocks.BeerInteractor{}
+			service := adapters.NewBeerService(interactor, pageTokens)
+			_, actual := service.UpdateBeer(test.ctx, &beers.UpdateBeerRequest{
+				Beer:       &beers.Beer{Id: "id", Name: "name"},
+				UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+				Etag:       test.etag,
+			})
+			assert.Equal(s, test.expected, actual)
+			interactor.AssertNotCalled(s, "UpdateBeer")
+		})
+	}
+}
+
+func TestDeleteBeer_WhenIfMatchSpecified_PassesVersionToInteractor(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"3"`))
+	version := int64(3)
+	interactor.On("DeleteBeer", ctx, &domain.DeleteBeerParams{ID: "id", Version: &version}).
+		Return(domain.NewConflictError("beer 'id' has been modified"))
+	_, actual := service.DeleteBeer(ctx, &beers.DeleteBeerRequest{Id: "id"})
+	assert.Equal(t, status.Error(codes.Aborted, "beer 'id' has been modified"), actual)
+}
+
+func TestDeleteBeer_WhenETagInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	_, actual := service.DeleteBeer(context.Background(), &beers.DeleteBeerRequest{Id: "id", Etag: "-1"})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "invalid etag '-1'"), actual)
+	interactor.AssertNotCalled(t, "DeleteBeer")
+}
+
 func TestListBeers_WhenListBeersReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	const msg = "something went wrong"
 	expected := status.Error(codes.Internal, msg)
-	interactor.On("ListBeers", ctx, &domain.ListBeersParams{Page: 42}).Return(nil, errors.New(msg))
-	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{Page: 42})
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 42}).Return(nil, errors.New(msg))
+	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 42})
 	assert.Equal(t, expected, actual)
 }
 
 func TestListBeers_WhenListBeersReturnsValidationError_ReturnsInvalidArgumentError(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	const msg = "something went wrong"
 	expected := status.Error(codes.InvalidArgument, msg)
-	interactor.On("ListBeers", ctx, &domain.ListBeersParams{Page: 42}).Return(nil, domain.NewValidationError(msg))
-	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{Page: 42})
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 42}).Return(nil, domain.NewValidationError(msg))
+	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 42})
 	assert.Equal(t, expected, actual)
 }
 
 func TestListBeers_WhenListBeersReturnsBeers_ReturnsBeers(t *testing.T) {
 	t.Parallel()
 	interactor := &mocks.BeerInteractor{}
-	service := adapters.NewBeerService(interactor)
+	service := adapters.NewBeerService(interactor, pageTokens)
 	ctx := context.Background()
 	expected := &beers.ListBeersResponse{
 		Beers: []*beers.Beer{
-			{Id: "id1", Type: beers.BeerType_BEER_TYPE_PILSNER},
-			{Id: "id2", Type: beers.BeerType_BEER_TYPE_PORTER},
-			{Id: "id3", Type: beers.BeerType_BEER_TYPE_PALE_ALE},
-			{Id: "id4", Type: beers.BeerType_BEER_TYPE_UNSPECIFIED},
+			{Id: "id1", Type: beers.BeerType_BEER_TYPE_PILSNER, Etag: "1"},
+			{Id: "id2", Type: beers.BeerType_BEER_TYPE_PORTER, Etag: "2"},
+			{Id: "id3", Type: beers.BeerType_BEER_TYPE_PALE_ALE, Etag: "3"},
+			{Id: "id4", Type: beers.BeerType_BEER_TYPE_UNSPECIFIED, Etag: "4"},
 		},
+		TotalSize: 4,
 	}
-	interactor.On("ListBeers", ctx, &domain.ListBeersParams{Page: 42}).Return([]*domain.Beer{
-		{ID: expected.Beers[0].Id, Type: domain.Pilsner},
-		{ID: expected.Beers[1].Id, Type: domain.Porter},
-		{ID: expected.Beers[2].Id, Type: domain.PaleAle},
-		{ID: expected.Beers[3].Id, Type: domain.Unspecified},
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 42}).Return(&domain.ListBeersResult{
+		Beers: []*domain.Beer{
+			{ID: expected.Beers[0].Id, Type: domain.Pilsner, Version: 1},
+			{ID: expected.Beers[1].Id, Type: domain.Porter, Version: 2},
+			{ID: expected.Beers[2].Id, Type: domain.PaleAle, Version: 3},
+			{ID: expected.Beers[3].Id, Type: domain.Unspecified, Version: 4},
+		},
+		TotalSize: 4,
 	}, nil)
-	actual, _ := service.ListBeers(ctx, &beers.ListBeersRequest{Page: 42})
+	actual, _ := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 42})
 	assert.Equal(t, expected, actual)
 }
+
+func TestListBeers_WhenFiltersSpecified_PassesFiltersToInteractor(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	stout := domain.Stout
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{
+		Type:        &stout,
+		Brewer:      "brewer",
+		Country:     "country",
+		NamePrefix:  "prefix",
+		OrderBy:     "name desc",
+		ShowDeleted: true,
+	}).Return(&domain.ListBeersResult{}, nil)
+	_, err := service.ListBeers(ctx, &beers.ListBeersRequest{
+		Type:        beers.BeerType_BEER_TYPE_STOUT,
+		Brewer:      "brewer",
+		Country:     "country",
+		NamePrefix:  "prefix",
+		OrderBy:     "name desc",
+		ShowDeleted: true,
+	})
+	assert.Nil(t, err)
+	interactor.AssertExpectations(t)
+}
+
+func TestListBeers_WhenMoreBeers_ReturnsNextPageTokenForNextPage(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	next := &domain.Cursor{ID: "id1", Name: "name", Type: domain.Ale, Brewer: "brewer", Country: "country"}
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 1, OrderBy: "name"}).Return(&domain.ListBeersResult{
+		Beers:     []*domain.Beer{{ID: "id1", Name: "name", Type: domain.Ale, Brewer: "brewer", Country: "country"}},
+		Next:      next,
+		TotalSize: 2,
+	}, nil)
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 1, OrderBy: "name", After: next}).Return(&domain.ListBeersResult{
+		Beers:     []*domain.Beer{{ID: "id2"}},
+		TotalSize: 2,
+	}, nil)
+
+	first, err := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 1, OrderBy: "name"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, first.NextPageToken)
+
+	second, err := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 1, OrderBy: "name", PageToken: first.NextPageToken})
+	assert.Nil(t, err)
+	assert.Equal(t, "id2", second.Beers[0].Id)
+	assert.Empty(t, second.NextPageToken)
+}
+
+func TestListBeers_WhenShowDeletedDiffersFromPageToken_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("ListBeers", ctx, &domain.ListBeersParams{PageSize: 1}).Return(&domain.ListBeersResult{
+		Beers: []*domain.Beer{{ID: "id1"}},
+		Next:  &domain.Cursor{ID: "id1"},
+	}, nil)
+	first, err := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 1})
+	assert.Nil(t, err)
+	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 1, ShowDeleted: true, PageToken: first.NextPageToken})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "invalid page token"), actual)
+}
+
+func TestListBeers_WhenPageTokenInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	expected := status.Error(codes.InvalidArgument, "invalid page token")
+	_, actual := service.ListBeers(ctx, &beers.ListBeersRequest{PageToken: "not a token"})
+	assert.Equal(t, expected, actual)
+}
+
+func TestSearchBeers_WhenSearchBeersReturnsResults_ReturnsResults(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("SearchBeers", ctx, &domain.SearchBeersParams{Query: "orval", PageSize: 5}).Return(&domain.SearchBeersResult{
+		Results: []*domain.SearchResult{
+			{
+				Beer:     &domain.Beer{ID: "id1", Name: "Orval", Type: domain.Ale, Version: 2},
+				Score:    0.75,
+				Snippets: []*domain.Snippet{{Field: "name", Text: "<b>Orval</b>"}},
+			},
+			{Beer: &domain.Beer{ID: "id2", Name: "Orvil", Type: domain.Ale, Version: 1}, Score: 0.5},
+		},
+	}, nil)
+	actual, err := service.SearchBeers(ctx, &beers.SearchBeersRequest{Q: "orval", PageSize: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.SearchBeersResponse{
+		Results: []*beers.SearchResult{
+			{
+				Beer:     &beers.Beer{Id: "id1", Name: "Orval", Type: beers.BeerType_BEER_TYPE_ALE, Etag: "2"},
+				Score:    0.75,
+				Snippets: []*beers.Snippet{{Field: "name", Text: "<b>Orval</b>"}},
+			},
+			{
+				Beer:     &beers.Beer{Id: "id2", Name: "Orvil", Type: beers.BeerType_BEER_TYPE_ALE, Etag: "1"},
+				Score:    0.5,
+				Snippets: []*beers.Snippet{},
+			},
+		},
+	}, actual)
+}
+
+func TestSearchBeers_WhenSearchBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("SearchBeers", ctx, &domain.SearchBeersParams{}).Return(nil, domain.NewValidationError("search query is empty"))
+	_, actual := service.SearchBeers(ctx, &beers.SearchBeersRequest{})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "search query is empty"), actual)
+}
+
+func TestSearchBeers_WhenMoreResults_ReturnsNextPageTokenForNextPage(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	next := &domain.Cursor{ID: "id1", Score: 0.75}
+	interactor.On("SearchBeers", ctx, &domain.SearchBeersParams{Query: "orval", PageSize: 1}).Return(&domain.SearchBeersResult{
+		Results: []*domain.SearchResult{{Beer: &domain.Beer{ID: "id1"}, Score: 0.75}},
+		Next:    next,
+	}, nil)
+	interactor.On("SearchBeers", ctx, &domain.SearchBeersParams{Query: "orval", PageSize: 1, After: next}).Return(&domain.SearchBeersResult{
+		Results: []*domain.SearchResult{{Beer: &domain.Beer{ID: "id2"}, Score: 0.5}},
+	}, nil)
+
+	first, err := service.SearchBeers(ctx, &beers.SearchBeersRequest{Q: "orval", PageSize: 1})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, first.NextPageToken)
+
+	second, err := service.SearchBeers(ctx, &beers.SearchBeersRequest{Q: "orval", PageSize: 1, PageToken: first.NextPageToken})
+	assert.Nil(t, err)
+	assert.Equal(t, "id2", second.Results[0].Beer.Id)
+	assert.Empty(t, second.NextPageToken)
+
+	// Page tokens are bound to the query, and to searching rather than
+	// listing.
+	_, actual := service.SearchBeers(ctx, &beers.SearchBeersRequest{Q: "chimay", PageSize: 1, PageToken: first.NextPageToken})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "invalid page token"), actual)
+	_, actual = service.ListBeers(ctx, &beers.ListBeersRequest{PageSize: 1, PageToken: first.NextPageToken})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "invalid page token"), actual)
+}
+
+func TestGetBeer_WhenGetBeerReturnsDomainError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	const msg = "something went wrong"
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "validation error",
+			err:      domain.NewValidationError(msg),
+			expected: status.Error(codes.InvalidArgument, msg),
+		},
+		{
+			name:     "not found error",
+			err:      domain.NewNotFoundError(msg),
+			expected: status.Error(codes.NotFound, msg),
+		},
+		{
+			name:     "already exists error",
+			err:      domain.NewAlreadyExistsError(msg),
+			expected: status.Error(codes.AlreadyExists, msg),
+		},
+		{
+			name:     "conflict error",
+			err:      domain.NewConflictError(msg),
+			expected: status.Error(codes.Aborted, msg),
+		},
+		{
+			name:     "failed precondition error",
+			err:      domain.NewFailedPreconditionError(msg),
+			expected: status.Error(codes.FailedPrecondition, msg),
+		},
+		{
+			name:     "permission denied error",
+			err:      domain.NewPermissionDeniedError(msg),
+			expected: status.Error(codes.PermissionDenied, msg),
+		},
+		{
+			name:     "unauthenticated error",
+			err:      domain.NewUnauthenticatedError(msg),
+			expected: status.Error(codes.Unauthenticated, msg),
+		},
+		{
+			name:     "resource exhausted error",
+			err:      domain.NewResourceExhaustedError(msg),
+			expected: status.Error(codes.ResourceExhausted, msg),
+		},
+		{
+			name:     "canceled error",
+			err:      context.Canceled,
+			expected: status.Error(codes.Canceled, "context canceled"),
+		},
+		{
+			name:     "deadline exceeded error",
+			err:      fmt.Errorf("canceling statement due to statement timeout: %w", context.DeadlineExceeded),
+			expected: status.Error(codes.DeadlineExceeded, "canceling statement due to statement timeout: context deadline exceeded"),
+		},
+		{
+			name:     "wrapped not found error",
+			err:      fmt.Errorf("wrapped: %w", domain.NewNotFoundError(msg)),
+			expected: status.Error(codes.NotFound, "wrapped: "+msg),
+		},
+		{
+			name:     "other error",
+			err:      errors.New(msg),
+			expected: status.Error(codes.Internal, msg),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			interactor := &mocks.BeerInteractor{}
+			service := adapters.NewBeerService(interactor, pageTokens)
+			ctx := context.Background()
+			interactor.On("GetBeer", ctx, &domain.GetBeerParams{ID: "id"}).Return(nil, test.err)
+			_, actual := service.GetBeer(ctx, &beers.GetBeerRequest{Id: "id"})
+			assert.Equal(s, test.expected, actual)
+		})
+	}
+}
+
+type watchBeersServer struct {
+	serverStream
+	events []*beers.BeerEvent
+}
+
+func (s *watchBeersServer) Send(event *beers.BeerEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestWatchBeers_WhenWatchBeersCallsHandler_SendsEvents(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &watchBeersServer{serverStream: serverStream{ctx: context.Background()}}
+	createTime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	expectedCreateTime, _ := ptypes.TimestampProto(createTime)
+	interactor.On("WatchBeers", stream.ctx, &domain.WatchBeersParams{ResumeToken: "token"}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(func(*domain.BeerEvent) error)
+			for _, event := range []*domain.BeerEvent{
+				{Type: domain.BeerCreated, Beer: &domain.Beer{ID: "id", Name: "name", Version: 1}, CreateTime: createTime, ResumeToken: "a"},
+				{Type: domain.BeerDeleted, Beer: &domain.Beer{ID: "id", Name: "name", Version: 2, DeleteTime: &createTime}, CreateTime: createTime, ResumeToken: "b"},
+			} {
+				assert.Nil(t, handler(event))
+			}
+		}).
+		Return(nil)
+	err := service.WatchBeers(&beers.WatchBeersRequest{ResumeToken: "token"}, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, []*beers.BeerEvent{
+		{
+			Type:        beers.BeerEventType_BEER_EVENT_TYPE_CREATED,
+			Beer:        &beers.Beer{Id: "id", Name: "name", Etag: "1"},
+			CreateTime:  expectedCreateTime,
+			ResumeToken: "a",
+		},
+		{
+			Type:        beers.BeerEventType_BEER_EVENT_TYPE_DELETED,
+			Beer:        &beers.Beer{Id: "id", Name: "name", Etag: "2", DeleteTime: expectedCreateTime},
+			CreateTime:  expectedCreateTime,
+			ResumeToken: "b",
+		},
+	}, stream.events)
+}
+
+func TestWatchBeers_WhenWatchBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &watchBeersServer{serverStream: serverStream{ctx: context.Background()}}
+	interactor.On("WatchBeers", stream.ctx, &domain.WatchBeersParams{ResumeToken: "token"}, mock.Anything).
+		Return(domain.NewFailedPreconditionError("resume token 'token' has expired"))
+	err := service.WatchBeers(&beers.WatchBeersRequest{ResumeToken: "token"}, stream)
+	assert.Equal(t, status.Error(codes.FailedPrecondition, "resume token 'token' has expired"), err)
+}
+
+type exportBeersServer struct {
+	serverStream
+	beers []*beers.Beer
+}
+
+func (s *exportBeersServer) Send(beer *beers.Beer) error {
+	s.beers = append(s.beers, beer)
+	return nil
+}
+
+func TestExportBeers_WhenExportBeersCallsHandler_SendsBeers(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &exportBeersServer{serverStream: serverStream{ctx: context.Background()}}
+	interactor.On("ExportBeers", stream.ctx, &domain.ExportBeersParams{ShowDeleted: true}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(func(*domain.Beer) error)
+			for _, beer := range []*domain.Beer{
+				{ID: "id1", Name: "name1", Type: domain.Ale, Version: 1},
+				{ID: "id2", Name: "name2", Type: domain.Stout, Version: 2},
+			} {
+				assert.Nil(t, handler(beer))
+			}
+		}).
+		Return(nil)
+	err := service.ExportBeers(&beers.ExportBeersRequest{ShowDeleted: true}, stream)
+	assert.Nil(t, err)
+	assert.Equal(t, []*beers.Beer{
+		{Id: "id1", Name: "name1", Type: beers.BeerType_BEER_TYPE_ALE, Etag: "1"},
+		{Id: "id2", Name: "name2", Type: beers.BeerType_BEER_TYPE_STOUT, Etag: "2"},
+	}, stream.beers)
+}
+
+func TestExportBeers_WhenExportBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &exportBeersServer{serverStream: serverStream{ctx: context.Background()}}
+	interactor.On("ExportBeers", stream.ctx, &domain.ExportBeersParams{}, mock.Anything).
+		Return(context.Canceled)
+	err := service.ExportBeers(&beers.ExportBeersRequest{}, stream)
+	assert.Equal(t, status.Error(codes.Canceled, "context canceled"), err)
+}
+
+type importBeersServer struct {
+	serverStream
+	requests []*beers.ImportBeersRequest
+	response *beers.ImportBeersResponse
+}
+
+func (s *importBeersServer) Recv() (*beers.ImportBeersRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importBeersServer) SendAndClose(resp *beers.ImportBeersResponse) error {
+	s.response = resp
+	return nil
+}
+
+func TestImportBeers_ImportsBeersInBatches(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	deleteTime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	protoDeleteTime, _ := ptypes.TimestampProto(deleteTime)
+
+	// The beers are streamed in chunks which do not line up with batches.
+	var (
+		chunks   []*beers.ImportBeersRequest
+		expected []*domain.Beer
+	)
+	for i := 0; i < domain.ImportBatchSize+1; i++ {
+		if i%30 == 0 {
+			chunks = append(chunks, &beers.ImportBeersRequest{})
+		}
+		id := fmt.Sprintf("id%03d", i)
+		chunk := chunks[len(chunks)-1]
+		chunk.Beers = append(chunk.Beers, &beers.Beer{
+			Id:         id,
+			Name:       "name",
+			Type:       beers.BeerType_BEER_TYPE_ALE,
+			Etag:       "5",
+			DeleteTime: protoDeleteTime,
+		})
+		expected = append(expected, &domain.Beer{ID: id, Name: "name", Type: domain.Ale, DeleteTime: &deleteTime})
+	}
+	stream := &importBeersServer{serverStream: serverStream{ctx: context.Background()}, requests: chunks}
+
+	interactor.On("ImportBeers", stream.ctx, &domain.ImportBeersParams{Beers: expected[:domain.ImportBatchSize]}).
+		Return(&domain.ImportBeersResult{
+			Created: domain.ImportBatchSize - 1,
+			Failed:  1,
+			Errors:  []domain.ImportError{{Index: 1, ID: "id001", Message: "beer name is empty"}},
+		}, nil).Once()
+	interactor.On("ImportBeers", stream.ctx, &domain.ImportBeersParams{Beers: expected[domain.ImportBatchSize:]}).
+		Return(&domain.ImportBeersResult{
+			Failed: 1,
+			Errors: []domain.ImportError{{Index: 0, ID: "id100", Message: "invalid beer type"}},
+		}, nil).Once()
+
+	err := service.ImportBeers(stream)
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.ImportBeersResponse{
+		CreatedCount: domain.ImportBatchSize - 1,
+		FailedCount:  2,
+		Errors: []*beers.ImportError{
+			{Index: 1, Id: "id001", Message: "beer name is empty"},
+			{Index: domain.ImportBatchSize, Id: "id100", Message: "invalid beer type"},
+		},
+	}, stream.response)
+	interactor.AssertExpectations(t)
+}
+
+func TestImportBeers_WhenNoBeers_ReturnsEmptySummary(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &importBeersServer{serverStream: serverStream{ctx: context.Background()}}
+	err := service.ImportBeers(stream)
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.ImportBeersResponse{Errors: []*beers.ImportError{}}, stream.response)
+	interactor.AssertNotCalled(t, "ImportBeers", mock.Anything, mock.Anything)
+}
+
+func TestImportBeers_WhenImportBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &importBeersServer{
+		serverStream: serverStream{ctx: context.Background()},
+		requests:     []*beers.ImportBeersRequest{{Beers: []*beers.Beer{{Id: "id", Name: "name"}}}},
+	}
+	interactor.On("ImportBeers", stream.ctx, mock.Anything).Return(nil, errors.New("something went wrong"))
+	err := service.ImportBeers(stream)
+	assert.Equal(t, status.Error(codes.Internal, "something went wrong"), err)
+	assert.Nil(t, stream.response)
+}
+
+func TestImportBeers_WhenDeleteTimeInvalid_FailsBeerAndImportsRest(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	stream := &importBeersServer{
+		serverStream: serverStream{ctx: context.Background()},
+		requests: []*beers.ImportBeersRequest{{Beers: []*beers.Beer{
+			{Id: "a", Name: "name"},
+			{Id: "b", Name: "name", DeleteTime: &timestamp.Timestamp{Nanos: -1}},
+			{Id: "c"},
+		}}},
+	}
+	interactor.On("ImportBeers", stream.ctx, &domain.ImportBeersParams{Beers: []*domain.Beer{
+		{ID: "a", Name: "name", Type: domain.Unspecified},
+		{ID: "c", Type: domain.Unspecified},
+	}}).
+		Return(&domain.ImportBeersResult{
+			Created: 1,
+			Failed:  1,
+			Errors:  []domain.ImportError{{Index: 1, ID: "c", Message: "beer name is empty"}},
+		}, nil).Once()
+
+	err := service.ImportBeers(stream)
+	assert.Nil(t, err)
+	assert.Len(t, stream.response.Errors, 2)
+	assert.Contains(t, stream.response.Errors[0].Message, "invalid delete time")
+	stream.response.Errors[0].Message = ""
+	assert.Equal(t, &beers.ImportBeersResponse{
+		CreatedCount: 1,
+		FailedCount:  2,
+		Errors: []*beers.ImportError{
+			{Index: 1, Id: "b"},
+			{Index: 2, Id: "c", Message: "beer name is empty"},
+		},
+	}, stream.response)
+	interactor.AssertExpectations(t)
+}
+
+func TestBatchGetBeers_WhenBatchGetBeersReturnsBeers_ReturnsBeers(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("BatchGetBeers", ctx, &domain.BatchGetBeersParams{IDs: []string{"b", "a"}}).
+		Return([]*domain.Beer{{ID: "b", Name: "b", Version: 2}, {ID: "a", Name: "a", Version: 1}}, nil)
+	actual, err := service.BatchGetBeers(ctx, &beers.BatchGetBeersRequest{Ids: []string{"b", "a"}})
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.BatchGetBeersResponse{Beers: []*beers.Beer{
+		{Id: "b", Name: "b", Etag: "2"},
+		{Id: "a", Name: "a", Etag: "1"},
+	}}, actual)
+}
+
+func TestBatchGetBeers_WhenBatchGetBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("BatchGetBeers", ctx, &domain.BatchGetBeersParams{IDs: []string{"a"}}).
+		Return(nil, domain.NewNotFoundError("beer 'a' not found"))
+	_, err := service.BatchGetBeers(ctx, &beers.BatchGetBeersRequest{Ids: []string{"a"}})
+	assert.Equal(t, status.Error(codes.NotFound, "beer 'a' not found"), err)
+}
+
+func TestBatchCreateBeers_WhenBatchCreateBeersReturnsBeers_ReturnsBeers(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("BatchCreateBeers", ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{
+		{Name: "a", Type: domain.Stout, Brewer: "brewer", Country: "country"},
+		{Name: "b", Type: domain.Unspecified},
+	}}).Return([]*domain.Beer{
+		{ID: "id1", Name: "a", Type: domain.Stout, Brewer: "brewer", Country: "country", Version: 1},
+		{ID: "id2", Name: "b", Type: domain.Unspecified, Version: 1},
+	}, nil)
+	actual, err := service.BatchCreateBeers(ctx, &beers.BatchCreateBeersRequest{Requests: []*beers.CreateBeerRequest{
+		{Name: "a", Type: beers.BeerType_BEER_TYPE_STOUT, Brewer: "brewer", Country: "country"},
+		{Name: "b"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &beers.BatchCreateBeersResponse{Beers: []*beers.Beer{
+		{Id: "id1", Name: "a", Type: beers.BeerType_BEER_TYPE_STOUT, Brewer: "brewer", Country: "country", Etag: "1"},
+		{Id: "id2", Name: "b", Type: beers.BeerType_BEER_TYPE_UNSPECIFIED, Etag: "1"},
+	}}, actual)
+}
+
+func TestBatchCreateBeers_WhenBatchCreateBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("BatchCreateBeers", ctx, mock.Anything).Return(nil, domain.NewValidationError("more than 100 beers in batch"))
+	_, err := service.BatchCreateBeers(ctx, &beers.BatchCreateBeersRequest{})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "more than 100 beers in batch"), err)
+}
+
+func TestBatchDeleteBeers_DeletesBeersWithETags(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	// The If-Match header does not apply to the beers of a batch.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("if-match", `"7"`))
+	version := int64(3)
+	interactor.On("BatchDeleteBeers", ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{
+		{ID: "a", Version: &version},
+		{ID: "b"},
+	}}).Return(nil)
+	actual, err := service.BatchDeleteBeers(ctx, &beers.BatchDeleteBeersRequest{Requests: []*beers.DeleteBeerRequest{
+		{Id: "a", Etag: `"3"`},
+		{Id: "b"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, &empty.Empty{}, actual)
+	interactor.AssertExpectations(t)
+}
+
+func TestBatchDeleteBeers_WhenETagInvalid_ReturnsInvalidArgumentError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	_, err := service.BatchDeleteBeers(context.Background(), &beers.BatchDeleteBeersRequest{Requests: []*beers.DeleteBeerRequest{
+		{Id: "a"},
+		{Id: "b", Etag: "x"},
+	}})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "requests[1]: invalid etag 'x'"), err)
+}
+
+func TestBatchDeleteBeers_WhenBatchDeleteBeersReturnsError_ReturnsMappedError(t *testing.T) {
+	t.Parallel()
+	interactor := &mocks.BeerInteractor{}
+	service := adapters.NewBeerService(interactor, pageTokens)
+	ctx := context.Background()
+	interactor.On("BatchDeleteBeers", ctx, mock.Anything).Return(domain.NewConflictError("beer 'a' has been modified"))
+	_, err := service.BatchDeleteBeers(ctx, &beers.BatchDeleteBeersRequest{Requests: []*beers.DeleteBeerRequest{{Id: "a"}}})
+	assert.Equal(t, status.Error(codes.Aborted, "beer 'a' has been modified"), err)
+}
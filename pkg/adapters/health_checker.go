@@ -0,0 +1,154 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Pinger pings a dependency to check that it is reachable.
+type Pinger interface {
+	// Ping returns an error if the dependency is unreachable.
+	Ping(ctx context.Context) error
+}
+
+// NewHealthChecker creates a new health checker which reports the health of
+// the service through the health server. The service is not serving until the
+// first check and then for as long as the pinger can be pinged.
+func NewHealthChecker(logger *logrus.Logger, pinger Pinger, healthServer *health.Server,
+	service string, timeout time.Duration) *HealthChecker {
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	healthServer.SetServingStatus(service, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return &HealthChecker{
+		logger:       logger,
+		pinger:       pinger,
+		healthServer: healthServer,
+		service:      service,
+		timeout:      timeout,
+	}
+}
+
+// HealthChecker checks the health of a service and reports it through the gRPC
+// health server and HTTP liveness and readiness handlers.
+type HealthChecker struct {
+	logger       *logrus.Logger
+	pinger       Pinger
+	healthServer *health.Server
+	service      string
+	timeout      time.Duration
+
+	mu       sync.RWMutex
+	ready    bool
+	shutdown bool
+}
+
+// Check pings the dependency once and updates the serving status, returning
+// true if the service is ready to serve.
+func (c *HealthChecker) Check(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	err := c.pinger.Ping(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shutdown {
+		return false
+	}
+	ready := err == nil
+	if ready != c.ready {
+		if ready {
+			c.logger.Infof("service '%s' is serving", c.service)
+		} else {
+			c.logger.Warnf("service '%s' is not serving: %v", c.service, err)
+		}
+	}
+	c.ready = ready
+
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if ready {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	c.healthServer.SetServingStatus("", status)
+	c.healthServer.SetServingStatus(c.service, status)
+	return ready
+}
+
+// Run checks the health of the service every interval until the context is
+// done.
+func (c *HealthChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ready returns true if the service is ready to serve.
+func (c *HealthChecker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ready
+}
+
+// Shutdown marks the service as not serving and ignores all future checks.
+func (c *HealthChecker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shutdown = true
+	c.ready = false
+	c.healthServer.Shutdown()
+}
+
+// LivenessHandler returns a HTTP handler which reports whether the process is
+// alive. It always responds with 200 OK while the process is able to serve
+// HTTP requests.
+func (c *HealthChecker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.writeStatus(w, grpc_health_v1.HealthCheckResponse_SERVING)
+	})
+}
+
+// ReadinessHandler returns a HTTP handler which reports whether the service is
+// ready to serve, responding with 200 OK if it is and 503 Service Unavailable
+// if it is not.
+func (c *HealthChecker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		if c.Ready() {
+			status = grpc_health_v1.HealthCheckResponse_SERVING
+		}
+		c.writeStatus(w, status)
+	})
+}
+
+func (c *HealthChecker) writeStatus(w http.ResponseWriter, status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	type Health struct {
+		Status string `json:"status"`
+	}
+	buf, err := json.Marshal(&Health{Status: status.String()})
+	if err != nil {
+		c.logger.Infof("failed to marshall health response: %v", err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if status == grpc_health_v1.HealthCheckResponse_SERVING {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := w.Write(buf); err != nil {
+		c.logger.Infof("failed to write response: %v", err)
+	}
+}
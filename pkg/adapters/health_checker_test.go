@@ -0,0 +1,103 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type pinger struct {
+	err error
+}
+
+func (p *pinger) Ping(ctx context.Context) error {
+	return p.err
+}
+
+func newHealthChecker(p adapters.Pinger) (*adapters.HealthChecker, *health.Server) {
+	logger, _ := test.NewNullLogger()
+	healthServer := health.NewServer()
+	return adapters.NewHealthChecker(logger, p, healthServer, "BeerService", time.Second), healthServer
+}
+
+func servingStatus(t *testing.T, healthServer *health.Server, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	resp, err := healthServer.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	assert.Nil(t, err)
+	return resp.Status
+}
+
+func get(handler http.Handler) (int, string) {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	resp := w.Result()
+	body, _ := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestHealthChecker_Check_WhenPingSucceeds_ReportsServing(t *testing.T) {
+	t.Parallel()
+	checker, healthServer := newHealthChecker(&pinger{})
+
+	assert.True(t, checker.Check(context.Background()))
+	assert.True(t, checker.Ready())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, healthServer, "BeerService"))
+
+	code, body := get(checker.ReadinessHandler())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "{\"status\":\"SERVING\"}", body)
+}
+
+func TestHealthChecker_Check_WhenPingFails_ReportsNotServing(t *testing.T) {
+	t.Parallel()
+	p := &pinger{}
+	checker, healthServer := newHealthChecker(p)
+	checker.Check(context.Background())
+
+	p.err = errors.New("connection refused")
+	assert.False(t, checker.Check(context.Background()))
+	assert.False(t, checker.Ready())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "BeerService"))
+
+	code, body := get(checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "{\"status\":\"NOT_SERVING\"}", body)
+
+	code, _ = get(checker.LivenessHandler())
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestHealthChecker_BeforeFirstCheck_ReportsNotServing(t *testing.T) {
+	t.Parallel()
+	checker, healthServer := newHealthChecker(&pinger{})
+
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, ""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "BeerService"))
+	code, _ := get(checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestHealthChecker_Shutdown_ReportsNotServingAndIgnoresChecks(t *testing.T) {
+	t.Parallel()
+	checker, healthServer := newHealthChecker(&pinger{})
+	checker.Check(context.Background())
+
+	checker.Shutdown()
+	assert.False(t, checker.Check(context.Background()))
+	assert.False(t, checker.Ready())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, healthServer, "BeerService"))
+
+	code, _ := get(checker.ReadinessHandler())
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
@@ -0,0 +1,232 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/golang-jwt/jwt"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeySet is a set of keys for verifying JWT signatures, identified by key ID.
+// Keys are either []byte HMAC secrets or *rsa.PublicKey RSA public keys.
+type KeySet struct {
+	keys map[string]interface{}
+}
+
+// jsonWebKey is a JSON web key as defined in RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// ParseJWKS parses a JSON web key set. Only symmetric keys for HS256 and RSA
+// keys for RS256 are supported.
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("invalid jwks: %w", err)
+	}
+
+	keys := make(map[string]interface{})
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if _, ok := keys[jwk.Kid]; ok {
+			return nil, fmt.Errorf("duplicate key id '%s'", jwk.Kid)
+		}
+		key, err := jwk.key()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key '%s': %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks has no signing keys")
+	}
+	return &KeySet{keys: keys}, nil
+}
+
+// key returns the key for verifying signatures.
+func (jwk *jsonWebKey) key() (interface{}, error) {
+	switch jwk.Kty {
+	case "oct":
+		if jwk.Alg != "" && jwk.Alg != jwt.SigningMethodHS256.Alg() {
+			return nil, fmt.Errorf("unsupported algorithm '%s'", jwk.Alg)
+		}
+		k, err := base64.RawURLEncoding.DecodeString(jwk.K)
+		if err != nil || len(k) == 0 {
+			return nil, errors.New("invalid symmetric key")
+		}
+		return k, nil
+	case "RSA":
+		if jwk.Alg != "" && jwk.Alg != jwt.SigningMethodRS256.Alg() {
+			return nil, fmt.Errorf("unsupported algorithm '%s'", jwk.Alg)
+		}
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil || len(n) == 0 {
+			return nil, errors.New("invalid RSA modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type '%s'", jwk.Kty)
+	}
+}
+
+// lookup returns the key with the key ID. A token without a key ID can only
+// be verified if the key set has exactly one key.
+func (ks *KeySet) lookup(kid string) (interface{}, error) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, nil
+		}
+	}
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id '%s'", kid)
+	}
+	return key, nil
+}
+
+// Authenticator authenticates the caller of a gRPC method.
+type Authenticator interface {
+	// Authenticate returns the principal of the caller making the request
+	// with the context, or an Unauthenticated error if the caller cannot be
+	// authenticated.
+	Authenticate(ctx context.Context) (*domain.Principal, error)
+}
+
+// NewJWTAuthenticator creates a new authenticator which authenticates callers
+// by the JWT bearer token in the authorization metadata. Tokens must be signed
+// by a key in the key set and, if not empty, be issued by the issuer for the
+// audience.
+func NewJWTAuthenticator(keys *KeySet, issuer, audience string) *JWTAuthenticator {
+	return &JWTAuthenticator{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		parser: &jwt.Parser{
+			ValidMethods: []string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()},
+		},
+	}
+}
+
+// JWTAuthenticator authenticates callers by JWT bearer tokens.
+type JWTAuthenticator struct {
+	keys     *KeySet
+	issuer   string
+	audience string
+	parser   *jwt.Parser
+}
+
+// Authenticate authenticates the caller by the JWT bearer token in the
+// authorization metadata.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context) (*domain.Principal, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+	principal, err := a.verify(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+	}
+	return principal, nil
+}
+
+// verify verifies the token and returns the principal it was issued for.
+func (a *JWTAuthenticator) verify(token string) (*domain.Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(token, claims, a.key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens without a numeric exp claim pass the expiry check of the parser,
+	// so would never expire.
+	if _, ok := claims["exp"].(float64); !ok {
+		return nil, errors.New("token has no expiry")
+	}
+	if a.issuer != "" && !claims.VerifyIssuer(a.issuer, true) {
+		return nil, errors.New("token has invalid issuer")
+	}
+	if a.audience != "" && !hasAudience(claims, a.audience) {
+		return nil, errors.New("token has invalid audience")
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	principal := &domain.Principal{Subject: subject}
+	roles, _ := claims["roles"].([]interface{})
+	for _, role := range roles {
+		if role, ok := role.(string); ok {
+			principal.Roles = append(principal.Roles, role)
+		}
+	}
+	return principal, nil
+}
+
+// key returns the key for verifying the token signature. The key type must
+// match the signing method so that, for instance, an RSA public key cannot be
+// used as an HMAC secret.
+func (a *JWTAuthenticator) key(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, err := a.keys.lookup(kid)
+	if err != nil {
+		return nil, err
+	}
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if _, ok := key.([]byte); !ok {
+			return nil, fmt.Errorf("key '%s' is not a symmetric key", kid)
+		}
+	case *jwt.SigningMethodRSA:
+		if _, ok := key.(*rsa.PublicKey); !ok {
+			return nil, fmt.Errorf("key '%s' is not an RSA key", kid)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected signing method '%s'", token.Method.Alg())
+	}
+	return key, nil
+}
+
+// hasAudience returns true if the aud claim, either a string or an array of
+// strings, contains the audience.
+func hasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
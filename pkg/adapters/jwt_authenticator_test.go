@@ -0,0 +1,307 @@
+package adapters_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	hmacSecret = []byte("a very secret hmac key")
+	rsaKey     = mustGenerateRSAKey()
+)
+
+func mustGenerateRSAKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func jwks() []byte {
+	enc := base64.RawURLEncoding
+	return []byte(fmt.Sprintf(`{"keys":[
+		{"kty":"oct","kid":"hmac","alg":"HS256","k":"%s"},
+		{"kty":"RSA","kid":"rsa","alg":"RS256","use":"sig","n":"%s","e":"%s"}
+	]}`,
+		enc.EncodeToString(hmacSecret),
+		enc.EncodeToString(rsaKey.N.Bytes()),
+		enc.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+	))
+}
+
+func newJWTAuthenticator(t *testing.T) *adapters.JWTAuthenticator {
+	keys, err := adapters.ParseJWKS(jwks())
+	assert.Nil(t, err)
+	return adapters.NewJWTAuthenticator(keys, "issuer", "beers")
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "subject",
+		"iss":   "issuer",
+		"aud":   "beers",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"viewer", "editor"},
+	}
+}
+
+func sign(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
+
+func withBearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestJWTAuthenticator_Authenticate_WhenTokenValid_ReturnsPrincipal(t *testing.T) {
+	t.Parallel()
+	expected := &domain.Principal{Subject: "subject", Roles: []string{"viewer", "editor"}}
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "HS256",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, validClaims()),
+		},
+		{
+			name:  "RS256",
+			token: sign(jwt.SigningMethodRS256, "rsa", rsaKey, validClaims()),
+		},
+		{
+			name: "audience array",
+			token: sign(jwt.SigningMethodRS256, "rsa", rsaKey, func() jwt.MapClaims {
+				claims := validClaims()
+				claims["aud"] = []string{"other", "beers"}
+				return claims
+			}()),
+		},
+	}
+
+	authenticator := newJWTAuthenticator(t)
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			principal, err := authenticator.Authenticate(withBearer(test.token))
+			assert.Nil(s, err)
+			assert.Equal(s, expected, principal)
+		})
+	}
+}
+
+func TestJWTAuthenticator_Authenticate_WhenTokenInvalid_ReturnsUnauthenticated(t *testing.T) {
+	t.Parallel()
+	with := func(key string, value interface{}) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	otherKey := mustGenerateRSAKey()
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "expired",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("exp", time.Now().Add(-time.Minute).Unix())),
+		},
+		{
+			name:  "no expiry",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("exp", nil)),
+		},
+		{
+			name:  "non numeric expiry",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("exp", "never")),
+		},
+		{
+			name:  "not yet valid",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("nbf", time.Now().Add(time.Hour).Unix())),
+		},
+		{
+			name:  "wrong issuer",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("iss", "other")),
+		},
+		{
+			name:  "wrong audience",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("aud", "other")),
+		},
+		{
+			name:  "no subject",
+			token: sign(jwt.SigningMethodHS256, "hmac", hmacSecret, with("sub", nil)),
+		},
+		{
+			name:  "wrong hmac secret",
+			token: sign(jwt.SigningMethodHS256, "hmac", []byte("wrong"), validClaims()),
+		},
+		{
+			name:  "wrong rsa key",
+			token: sign(jwt.SigningMethodRS256, "rsa", otherKey, validClaims()),
+		},
+		{
+			name:  "unknown key id",
+			token: sign(jwt.SigningMethodHS256, "unknown", hmacSecret, validClaims()),
+		},
+		{
+			name:  "no key id with multiple keys",
+			token: sign(jwt.SigningMethodHS256, "", hmacSecret, validClaims()),
+		},
+		{
+			name:  "rsa public key used as hmac secret",
+			token: sign(jwt.SigningMethodHS256, "rsa", []byte(fmt.Sprint(rsaKey.PublicKey)), validClaims()),
+		},
+		{
+			name:  "hmac key id with rsa signature",
+			token: sign(jwt.SigningMethodRS256, "hmac", rsaKey, validClaims()),
+		},
+		{
+			name:  "unsupported algorithm",
+			token: sign(jwt.SigningMethodHS512, "hmac", hmacSecret, validClaims()),
+		},
+		{
+			name:  "algorithm none",
+			token: sign(jwt.SigningMethodNone, "hmac", jwt.UnsafeAllowNoneSignatureType, validClaims()),
+		},
+		{
+			name:  "malformed",
+			token: "not.a.token",
+		},
+	}
+
+	authenticator := newJWTAuthenticator(t)
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := authenticator.Authenticate(withBearer(test.token))
+			assert.Equal(s, codes.Unauthenticated, status.Code(err))
+		})
+	}
+}
+
+func TestJWTAuthenticator_Authenticate_WhenNoToken_ReturnsUnauthenticated(t *testing.T) {
+	t.Parallel()
+	authenticator := newJWTAuthenticator(t)
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{
+			name: "no metadata",
+			ctx:  context.Background(),
+		},
+		{
+			name: "no authorization",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "id")),
+		},
+		{
+			name: "not bearer",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic dXNlcjpwYXNz")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := authenticator.Authenticate(test.ctx)
+			assert.Equal(s, codes.Unauthenticated, status.Code(err))
+		})
+	}
+}
+
+func TestJWTAuthenticator_Authenticate_WhenSingleKeyAndNoKeyID_UsesKey(t *testing.T) {
+	t.Parallel()
+	keys, err := adapters.ParseJWKS([]byte(fmt.Sprintf(`{"keys":[{"kty":"oct","k":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(hmacSecret))))
+	assert.Nil(t, err)
+	authenticator := adapters.NewJWTAuthenticator(keys, "", "")
+
+	principal, err := authenticator.Authenticate(withBearer(sign(jwt.SigningMethodHS256, "", hmacSecret, validClaims())))
+	assert.Nil(t, err)
+	assert.Equal(t, "subject", principal.Subject)
+}
+
+func TestParseJWKS_WhenInvalid_ReturnsError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		jwks string
+		err  string
+	}{
+		{
+			name: "not json",
+			jwks: "keys",
+			err:  "invalid jwks: invalid character 'k' looking for beginning of value",
+		},
+		{
+			name: "no keys",
+			jwks: `{"keys":[]}`,
+			err:  "jwks has no signing keys",
+		},
+		{
+			name: "only encryption keys",
+			jwks: `{"keys":[{"kty":"oct","kid":"enc","use":"enc","k":"c2VjcmV0"}]}`,
+			err:  "jwks has no signing keys",
+		},
+		{
+			name: "duplicate key id",
+			jwks: `{"keys":[{"kty":"oct","kid":"a","k":"c2VjcmV0"},{"kty":"oct","kid":"a","k":"c2VjcmV0"}]}`,
+			err:  "duplicate key id 'a'",
+		},
+		{
+			name: "unsupported key type",
+			jwks: `{"keys":[{"kty":"EC","kid":"ec"}]}`,
+			err:  "invalid key 'ec': unsupported key type 'EC'",
+		},
+		{
+			name: "unsupported algorithm",
+			jwks: `{"keys":[{"kty":"oct","kid":"a","alg":"HS512","k":"c2VjcmV0"}]}`,
+			err:  "invalid key 'a': unsupported algorithm 'HS512'",
+		},
+		{
+			name: "empty symmetric key",
+			jwks: `{"keys":[{"kty":"oct","kid":"a"}]}`,
+			err:  "invalid key 'a': invalid symmetric key",
+		},
+		{
+			name: "invalid rsa modulus",
+			jwks: `{"keys":[{"kty":"RSA","kid":"a","n":"!","e":"AQAB"}]}`,
+			err:  "invalid key 'a': invalid RSA modulus",
+		},
+		{
+			name: "invalid rsa exponent",
+			jwks: `{"keys":[{"kty":"RSA","kid":"a","n":"AQAB"}]}`,
+			err:  "invalid key 'a': invalid RSA exponent",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := adapters.ParseJWKS([]byte(test.jwks))
+			assert.EqualError(s, err, test.err)
+		})
+	}
+}
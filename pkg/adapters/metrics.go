@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NewHTTPMetricsMiddleware returns middleware which records the count,
+// latency and status codes of HTTP requests served by the gateway. The
+// metrics are registered with the registerer.
+func NewHTTPMetricsMiddleware(registerer prometheus.Registerer) (func(http.Handler) http.Handler, error) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests served by the gateway.",
+	}, []string{"code", "method"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests served by the gateway.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"code", "method"})
+	inFlight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "http_requests_in_flight",
+		Help: "Number of HTTP requests currently being served by the gateway.",
+	})
+	for _, c := range []prometheus.Collector{requests, duration, inFlight} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return func(next http.Handler) http.Handler {
+		return promhttp.InstrumentHandlerInFlight(inFlight,
+			promhttp.InstrumentHandlerDuration(duration,
+				promhttp.InstrumentHandlerCounter(requests, next)))
+	}, nil
+}
@@ -0,0 +1,60 @@
+package adapters_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// sampleCount returns the total number of observations of the histogram with
+// the name.
+func sampleCount(t *testing.T, registry *prometheus.Registry, name string) int {
+	families, err := registry.Gather()
+	assert.Nil(t, err)
+	count := 0
+	for _, family := range families {
+		if family.GetName() == name {
+			for _, metric := range family.GetMetric() {
+				count += int(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return count
+}
+
+func TestNewHTTPMetricsMiddleware_RecordsRequests(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	middleware, err := adapters.NewHTTPMetricsMiddleware(registry)
+	assert.Nil(t, err)
+
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/beers/id", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/beers/id", nil))
+
+	expected := `
+# HELP http_requests_total Total number of HTTP requests served by the gateway.
+# TYPE http_requests_total counter
+http_requests_total{code="404",method="get"} 2
+`
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "http_requests_total"))
+	assert.Equal(t, 2, sampleCount(t, registry, "http_request_duration_seconds"))
+}
+
+func TestNewHTTPMetricsMiddleware_WhenAlreadyRegistered_ReturnsError(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	_, err := adapters.NewHTTPMetricsMiddleware(registry)
+	assert.Nil(t, err)
+	_, err = adapters.NewHTTPMetricsMiddleware(registry)
+	assert.NotNil(t, err)
+}
@@ -14,6 +14,66 @@ type BeerInteractor struct {
 	mock.Mock
 }
 
+// BatchCreateBeers provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams) ([]*domain.Beer, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchCreateBeersParams) []*domain.Beer); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchCreateBeersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDeleteBeers provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchDeleteBeersParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BatchGetBeers provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchGetBeersParams) []*domain.Beer); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchGetBeersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateBeer provides a mock function with given fields: ctx, params
 func (_m *BeerInteractor) CreateBeer(ctx context.Context, params *domain.CreateBeerParams) (*domain.Beer, error) {
 	ret := _m.Called(ctx, params)
@@ -51,6 +111,20 @@ func (_m *BeerInteractor) DeleteBeer(ctx context.Context, params *domain.DeleteB
 	return r0
 }
 
+// ExportBeers provides a mock function with given fields: ctx, params, handler
+func (_m *BeerInteractor) ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error {
+	ret := _m.Called(ctx, params, handler)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ExportBeersParams, func(*domain.Beer) error) error); ok {
+		r0 = rf(ctx, params, handler)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBeer provides a mock function with given fields: ctx, params
 func (_m *BeerInteractor) GetBeer(ctx context.Context, params *domain.GetBeerParams) (*domain.Beer, error) {
 	ret := _m.Called(ctx, params)
@@ -74,16 +148,62 @@ func (_m *BeerInteractor) GetBeer(ctx context.Context, params *domain.GetBeerPar
 	return r0, r1
 }
 
+// ImportBeers provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) ImportBeers(ctx context.Context, params *domain.ImportBeersParams) (*domain.ImportBeersResult, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.ImportBeersResult
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ImportBeersParams) *domain.ImportBeersResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportBeersResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ImportBeersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBeerHistory provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) ListBeerHistory(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []*domain.AuditEntry
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListBeerHistoryParams) []*domain.AuditEntry); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.AuditEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ListBeerHistoryParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBeers provides a mock function with given fields: ctx, params
-func (_m *BeerInteractor) ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error) {
+func (_m *BeerInteractor) ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 []*domain.Beer
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListBeersParams) []*domain.Beer); ok {
+	var r0 *domain.ListBeersResult
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListBeersParams) *domain.ListBeersResult); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Beer)
+			r0 = ret.Get(0).(*domain.ListBeersResult)
 		}
 	}
 
@@ -97,6 +217,52 @@ func (_m *BeerInteractor) ListBeers(ctx context.Context, params *domain.ListBeer
 	return r0, r1
 }
 
+// SearchBeers provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.SearchBeersResult
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SearchBeersParams) *domain.SearchBeersResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SearchBeersResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SearchBeersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UndeleteBeer provides a mock function with given fields: ctx, params
+func (_m *BeerInteractor) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams) (*domain.Beer, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UndeleteBeerParams) *domain.Beer); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.UndeleteBeerParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateBeer provides a mock function with given fields: ctx, params
 func (_m *BeerInteractor) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error) {
 	ret := _m.Called(ctx, params)
@@ -119,3 +285,17 @@ func (_m *BeerInteractor) UpdateBeer(ctx context.Context, params *domain.UpdateB
 
 	return r0, r1
 }
+
+// WatchBeers provides a mock function with given fields: ctx, params, handler
+func (_m *BeerInteractor) WatchBeers(ctx context.Context, params *domain.WatchBeersParams, handler func(*domain.BeerEvent) error) error {
+	ret := _m.Called(ctx, params, handler)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.WatchBeersParams, func(*domain.BeerEvent) error) error); ok {
+		r0 = rf(ctx, params, handler)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
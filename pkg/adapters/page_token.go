@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewPageTokenCodec creates a new page token codec which signs page tokens
+// with the given key.
+func NewPageTokenCodec(key []byte) *PageTokenCodec {
+	return &PageTokenCodec{key: key}
+}
+
+// PageTokenCodec encodes and decodes the opaque page tokens returned to
+// clients when listing beers. Page tokens are signed so that clients cannot
+// tamper with them, and the signature covers the request which produced the
+// page token so that it cannot be used with different filters.
+type PageTokenCodec struct {
+	key []byte
+}
+
+// pageToken is the payload of a page token.
+type pageToken struct {
+	ID      string  `json:"i"`
+	Name    string  `json:"n,omitempty"`
+	Type    int     `json:"t,omitempty"`
+	Brewer  string  `json:"b,omitempty"`
+	Country string  `json:"c,omitempty"`
+	Score   float64 `json:"s,omitempty"`
+}
+
+// Encode encodes the cursor as a page token for the given request.
+func (c *PageTokenCodec) Encode(cursor *domain.Cursor, request string) (string, error) {
+	payload, err := json.Marshal(&pageToken{
+		ID:      cursor.ID,
+		Name:    cursor.Name,
+		Type:    int(cursor.Type),
+		Brewer:  cursor.Brewer,
+		Country: cursor.Country,
+		Score:   cursor.Score,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(c.sign(payload, request)), nil
+}
+
+// Decode decodes the page token for the given request into a cursor.
+func (c *PageTokenCodec) Decode(token string, request string) (*domain.Cursor, error) {
+	invalid := domain.NewValidationError("invalid page token")
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, invalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, invalid
+	}
+	if !hmac.Equal(signature, c.sign(payload, request)) {
+		return nil, invalid
+	}
+
+	var pt pageToken
+	if err := json.Unmarshal(payload, &pt); err != nil {
+		return nil, invalid
+	}
+	return &domain.Cursor{
+		ID:      pt.ID,
+		Name:    pt.Name,
+		Type:    domain.BeerType(pt.Type),
+		Brewer:  pt.Brewer,
+		Country: pt.Country,
+		Score:   pt.Score,
+	}, nil
+}
+
+func (c *PageTokenCodec) sign(payload []byte, request string) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(request))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// listBeersRequestKey returns the parts of a list beers request which a page
+// token is bound to, i.e. everything except the page size and page token.
+func listBeersRequestKey(params *domain.ListBeersParams) string {
+	var buf bytes.Buffer
+	if params.Type != nil {
+		buf.WriteString(params.Type.String())
+	}
+	for _, s := range []string{params.Brewer, params.Country, params.NamePrefix, params.OrderBy} {
+		buf.WriteByte(0)
+		buf.WriteString(s)
+	}
+	if params.ShowDeleted {
+		buf.WriteString("\x00deleted")
+	}
+	return buf.String()
+}
+
+// searchBeersRequestKey returns the parts of a search beers request which a
+// page token is bound to, which differ from those of every list beers request.
+func searchBeersRequestKey(params *domain.SearchBeersParams) string {
+	return "search\x00" + params.Query
+}
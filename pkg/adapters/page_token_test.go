@@ -0,0 +1,58 @@
+package adapters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageTokenCodec_WhenTokenDecoded_ReturnsEncodedCursor(t *testing.T) {
+	t.Parallel()
+	codec := adapters.NewPageTokenCodec([]byte("secret"))
+	expected := &domain.Cursor{ID: "id", Name: "name", Type: domain.Stout, Brewer: "brewer", Country: "country", Score: 0.1 + 0.2}
+	token, err := codec.Encode(expected, "request")
+	assert.Nil(t, err)
+	actual, err := codec.Decode(token, "request")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPageTokenCodec_WhenRequestDiffers_ReturnsValidationError(t *testing.T) {
+	t.Parallel()
+	codec := adapters.NewPageTokenCodec([]byte("secret"))
+	token, _ := codec.Encode(&domain.Cursor{ID: "id"}, "request")
+	_, err := codec.Decode(token, "another request")
+	assert.Equal(t, domain.NewValidationError("invalid page token"), err)
+}
+
+func TestPageTokenCodec_WhenKeyDiffers_ReturnsValidationError(t *testing.T) {
+	t.Parallel()
+	token, _ := adapters.NewPageTokenCodec([]byte("secret")).Encode(&domain.Cursor{ID: "id"}, "request")
+	_, err := adapters.NewPageTokenCodec([]byte("another secret")).Decode(token, "request")
+	assert.Equal(t, domain.NewValidationError("invalid page token"), err)
+}
+
+func TestPageTokenCodec_WhenTokenTamperedWith_ReturnsValidationError(t *testing.T) {
+	t.Parallel()
+	codec := adapters.NewPageTokenCodec([]byte("secret"))
+	token, _ := codec.Encode(&domain.Cursor{ID: "id"}, "request")
+	other, _ := codec.Encode(&domain.Cursor{ID: "other"}, "request")
+	tampered := strings.Split(other, ".")[0] + "." + strings.Split(token, ".")[1]
+
+	tests := []string{
+		"",
+		"garbage",
+		"a.b.c",
+		"!!!." + strings.Split(token, ".")[1],
+		strings.Split(token, ".")[0] + ".!!!",
+		tampered,
+	}
+	for _, test := range tests {
+		_, err := codec.Decode(test, "request")
+		assert.Equal(t, domain.NewValidationError("invalid page token"), err)
+	}
+}
@@ -0,0 +1,234 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// sweepInterval is the interval between removals of full token buckets, which
+// behave the same as new token buckets.
+const sweepInterval = time.Minute
+
+// RateLimit is a token bucket rate limit. A bucket holds up to Burst tokens
+// and is refilled with Rate tokens per second. Each call takes a token from
+// the bucket, and calls are rejected while the bucket is empty.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Validate validates the rate limit.
+func (l RateLimit) Validate() error {
+	if l.Rate <= 0 {
+		return fmt.Errorf("invalid rate %g", l.Rate)
+	}
+	if l.Burst < 1 {
+		return fmt.Errorf("invalid burst %d", l.Burst)
+	}
+	return nil
+}
+
+// RateLimitResult is the result of taking a token from a token bucket.
+type RateLimitResult struct {
+	// Allowed is true if a token was taken.
+	Allowed bool
+	// Limit is the size of the bucket.
+	Limit int
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int
+	// Reset is the time until the bucket is full.
+	Reset time.Duration
+	// RetryAfter is the time until a token is available if not allowed.
+	RetryAfter time.Duration
+}
+
+// NewRateLimiter creates a new rate limiter which limits calls to each method
+// by each client with the method limit, or the default limit for methods
+// without one. Method limits are keyed by full method name, e.g.
+// "/BeerService/ListBeers".
+func NewRateLimiter(defaultLimit RateLimit, methodLimits map[string]RateLimit) (*RateLimiter, error) {
+	if err := defaultLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid default rate limit: %w", err)
+	}
+	limits := make(map[string]RateLimit, len(methodLimits))
+	for method, limit := range methodLimits {
+		if _, ok := methodPermissions[method]; !ok {
+			return nil, fmt.Errorf("unknown method '%s'", method)
+		}
+		if err := limit.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rate limit for method '%s': %w", method, err)
+		}
+		limits[method] = limit
+	}
+	return &RateLimiter{
+		defaultLimit: defaultLimit,
+		methodLimits: limits,
+		buckets:      make(map[bucketKey]*bucket),
+	}, nil
+}
+
+// RateLimiter limits the rate of calls to methods by clients with token
+// buckets. It is safe for concurrent use.
+type RateLimiter struct {
+	defaultLimit RateLimit
+	methodLimits map[string]RateLimit
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	method string
+	client string
+}
+
+type bucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// refill refills the bucket with the tokens accrued since it was last
+// refilled.
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.last = now
+	}
+}
+
+// Allow takes a token from the bucket of the client for the method at the
+// time now.
+func (l *RateLimiter) Allow(method, client string, now time.Time) RateLimitResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	key := bucketKey{method: method, client: client}
+	b, ok := l.buckets[key]
+	if !ok {
+		limit, ok := l.methodLimits[method]
+		if !ok {
+			limit = l.defaultLimit
+		}
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+
+	result := RateLimitResult{Limit: b.limit.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / b.limit.Rate)
+	}
+	result.Remaining = int(b.tokens)
+	result.Reset = seconds((float64(b.limit.Burst) - b.tokens) / b.limit.Rate)
+	return result
+}
+
+// sweep removes the buckets which are full, as they behave the same as new
+// buckets, so that the buckets of clients which stopped calling are freed.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// rateLimitClient returns the client calls on the context are rate limited
+// by. Authenticated callers are limited by their principal, which for callers
+// authenticating with an API key is the API key. Other callers are limited by
+// their IP address. Calls proxied by the HTTP gateway, which connects over
+// loopback, are limited by the IP address the gateway received the request
+// from.
+func rateLimitClient(ctx context.Context) string {
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		return "principal:" + principal.Subject
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "ip:unknown"
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.IsLoopback() {
+		// The gateway appends the address it received the request from to
+		// any X-Forwarded-For header of the request.
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			addresses := strings.Split(forwarded[len(forwarded)-1], ",")
+			ip = strings.TrimSpace(addresses[len(addresses)-1])
+		}
+	}
+	return "ip:" + ip
+}
+
+// rateLimitHeader returns the RateLimit-* headers, and the Retry-After header
+// if the call was not allowed, describing the result.
+func rateLimitHeader(result RateLimitResult) metadata.MD {
+	md := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(result.Limit),
+		"ratelimit-remaining", strconv.Itoa(result.Remaining),
+		"ratelimit-reset", strconv.Itoa(ceilSeconds(result.Reset)),
+	)
+	if !result.Allowed {
+		md.Set("retry-after", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+	}
+	return md
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// NewRateLimitUnaryServerInterceptor returns a new unary server interceptor
+// which limits the rate of calls by each client with the rate limiter. The
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers are sent
+// with every response, and calls which are not allowed return
+// ResourceExhausted with the Retry-After header. The public methods are not
+// rate limited.
+func NewRateLimitUnaryServerInterceptor(limiter *RateLimiter, publicMethods ...string) grpc.UnaryServerInterceptor {
+	public := toSet(publicMethods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		result := limiter.Allow(info.FullMethod, rateLimitClient(ctx), time.Now())
+		if err := grpc.SetHeader(ctx, rateLimitHeader(result)); err != nil {
+			return nil, err
+		}
+		if !result.Allowed {
+			return nil, toError(domain.NewResourceExhaustedError(
+				fmt.Sprintf("rate limit exceeded, retry after %s", result.RetryAfter.Round(time.Millisecond))))
+		}
+		return handler(ctx, req)
+	}
+}
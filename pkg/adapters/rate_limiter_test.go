@@ -0,0 +1,251 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// transportStream is a grpc.ServerTransportStream which records the header
+// set by the server.
+type transportStream struct {
+	header metadata.MD
+}
+
+func (s *transportStream) Method() string {
+	return ""
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *transportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+// withPeer returns a context of a call from the peer address carrying the
+// metadata, and the stream recording the header set by the server.
+func withPeer(address string, md metadata.MD) (context.Context, *transportStream) {
+	addr, err := net.ResolveTCPAddr("tcp", address)
+	if err != nil {
+		panic(err)
+	}
+	stream := &transportStream{}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	ctx = metadata.NewIncomingContext(ctx, md)
+	return grpc.NewContextWithServerTransportStream(ctx, stream), stream
+}
+
+func mustNewRateLimiter(defaultLimit adapters.RateLimit, methodLimits map[string]adapters.RateLimit) *adapters.RateLimiter {
+	limiter, err := adapters.NewRateLimiter(defaultLimit, methodLimits)
+	if err != nil {
+		panic(err)
+	}
+	return limiter
+}
+
+func TestNewRateLimiter_WhenInvalid_ReturnsError(t *testing.T) {
+	t.Parallel()
+	valid := adapters.RateLimit{Rate: 1, Burst: 1}
+	tests := []struct {
+		name         string
+		defaultLimit adapters.RateLimit
+		methodLimits map[string]adapters.RateLimit
+		err          string
+	}{
+		{
+			name:         "invalid default rate",
+			defaultLimit: adapters.RateLimit{Rate: 0, Burst: 1},
+			err:          "invalid default rate limit: invalid rate 0",
+		},
+		{
+			name:         "invalid default burst",
+			defaultLimit: adapters.RateLimit{Rate: 1, Burst: 0},
+			err:          "invalid default rate limit: invalid burst 0",
+		},
+		{
+			name:         "unknown method",
+			defaultLimit: valid,
+			methodLimits: map[string]adapters.RateLimit{"/BeerService/DrinkBeer": valid},
+			err:          "unknown method '/BeerService/DrinkBeer'",
+		},
+		{
+			name:         "invalid method rate",
+			defaultLimit: valid,
+			methodLimits: map[string]adapters.RateLimit{"/BeerService/ListBeers": {Rate: -1, Burst: 1}},
+			err:          "invalid rate limit for method '/BeerService/ListBeers': invalid rate -1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := adapters.NewRateLimiter(test.defaultLimit, test.methodLimits)
+			assert.EqualError(s, err, test.err)
+		})
+	}
+}
+
+func TestRateLimiter_Allow_LimitsRateByMethodAndClient(t *testing.T) {
+	t.Parallel()
+	limiter := mustNewRateLimiter(adapters.RateLimit{Rate: 1, Burst: 2}, map[string]adapters.RateLimit{
+		"/BeerService/ListBeers": {Rate: 0.5, Burst: 1},
+	})
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, adapters.RateLimitResult{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second},
+		limiter.Allow("/BeerService/GetBeer", "a", now))
+	assert.Equal(t, adapters.RateLimitResult{Allowed: true, Limit: 2, Remaining: 0, Reset: 2 * time.Second},
+		limiter.Allow("/BeerService/GetBeer", "a", now))
+	assert.Equal(t, adapters.RateLimitResult{Allowed: false, Limit: 2, Remaining: 0, Reset: 2 * time.Second, RetryAfter: time.Second},
+		limiter.Allow("/BeerService/GetBeer", "a", now))
+
+	// Other clients and methods have their own buckets.
+	assert.True(t, limiter.Allow("/BeerService/GetBeer", "b", now).Allowed)
+	assert.Equal(t, adapters.RateLimitResult{Allowed: true, Limit: 1, Remaining: 0, Reset: 2 * time.Second},
+		limiter.Allow("/BeerService/ListBeers", "a", now))
+	assert.Equal(t, adapters.RateLimitResult{Allowed: false, Limit: 1, Remaining: 0, Reset: time.Second, RetryAfter: time.Second},
+		limiter.Allow("/BeerService/ListBeers", "a", now.Add(time.Second)))
+
+	// Buckets are refilled over time.
+	assert.Equal(t, adapters.RateLimitResult{Allowed: true, Limit: 2, Remaining: 0, Reset: 1500 * time.Millisecond},
+		limiter.Allow("/BeerService/GetBeer", "a", now.Add(1500*time.Millisecond)))
+	assert.Equal(t, adapters.RateLimitResult{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second},
+		limiter.Allow("/BeerService/GetBeer", "a", now.Add(time.Hour)))
+}
+
+func TestNewRateLimitUnaryServerInterceptor_WhenAllowed_SetsRateLimitHeaders(t *testing.T) {
+	t.Parallel()
+	limiter := mustNewRateLimiter(adapters.RateLimit{Rate: 1, Burst: 2}, nil)
+	interceptor := adapters.NewRateLimitUnaryServerInterceptor(limiter)
+	ctx, stream := withPeer("10.0.0.1:1234", nil)
+	resp, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "resp", nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "resp", resp)
+	assert.Equal(t, metadata.Pairs("ratelimit-limit", "2", "ratelimit-remaining", "1", "ratelimit-reset", "1"), stream.header)
+}
+
+func TestNewRateLimitUnaryServerInterceptor_WhenLimitExceeded_ReturnsResourceExhausted(t *testing.T) {
+	t.Parallel()
+	limiter := mustNewRateLimiter(adapters.RateLimit{Rate: 0.5, Burst: 1}, nil)
+	interceptor := adapters.NewRateLimitUnaryServerInterceptor(limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "resp", nil
+	}
+
+	ctx, _ := withPeer("10.0.0.1:1234", nil)
+	_, err := interceptor(ctx, "req", info, handler)
+	assert.Nil(t, err)
+
+	ctx, stream := withPeer("10.0.0.1:1234", nil)
+	called := false
+	_, err = interceptor(ctx, "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "resp", nil
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.False(t, called)
+	assert.Equal(t, []string{"2"}, stream.header.Get("retry-after"))
+	assert.Equal(t, []string{"0"}, stream.header.Get("ratelimit-remaining"))
+}
+
+func TestNewRateLimitUnaryServerInterceptor_LimitsByClient(t *testing.T) {
+	t.Parallel()
+	withPrincipal := func(subject, address string) context.Context {
+		ctx, _ := withPeer(address, nil)
+		return domain.NewContextWithPrincipal(ctx, &domain.Principal{Subject: subject})
+	}
+	withForwardedFor := func(address, forwardedFor string) context.Context {
+		ctx, _ := withPeer(address, metadata.Pairs("x-forwarded-for", forwardedFor))
+		return ctx
+	}
+	tests := []struct {
+		name    string
+		first   context.Context
+		second  context.Context
+		limited bool
+	}{
+		{
+			name:    "same principal from different addresses",
+			first:   withPrincipal("subject", "10.0.0.1:1234"),
+			second:  withPrincipal("subject", "10.0.0.2:1234"),
+			limited: true,
+		},
+		{
+			name:   "different principals from same address",
+			first:  withPrincipal("apikey:a", "10.0.0.1:1234"),
+			second: withPrincipal("apikey:b", "10.0.0.1:1234"),
+		},
+		{
+			name:    "same address",
+			first:   withForwardedFor("10.0.0.1:1234", "192.168.0.1"),
+			second:  withForwardedFor("10.0.0.1:4321", "192.168.0.2"),
+			limited: true,
+		},
+		{
+			name:   "different addresses forwarded by gateway",
+			first:  withForwardedFor("127.0.0.1:1234", "10.0.0.1, 192.168.0.1"),
+			second: withForwardedFor("127.0.0.1:1234", "10.0.0.1, 192.168.0.2"),
+		},
+		{
+			name:    "same address forwarded by gateway",
+			first:   withForwardedFor("127.0.0.1:1234", "10.0.0.1, 192.168.0.1"),
+			second:  withForwardedFor("127.0.0.1:1234", "10.0.0.2, 192.168.0.1"),
+			limited: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			limiter := mustNewRateLimiter(adapters.RateLimit{Rate: 0.5, Burst: 1}, nil)
+			interceptor := adapters.NewRateLimitUnaryServerInterceptor(limiter)
+			info := &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "resp", nil
+			}
+			_, err := interceptor(test.first, "req", info, handler)
+			assert.Nil(s, err)
+			_, err = interceptor(test.second, "req", info, handler)
+			if test.limited {
+				assert.Equal(s, codes.ResourceExhausted, status.Code(err))
+			} else {
+				assert.Nil(s, err)
+			}
+		})
+	}
+}
+
+func TestNewRateLimitUnaryServerInterceptor_WhenMethodPublic_DoesNotLimit(t *testing.T) {
+	t.Parallel()
+	limiter := mustNewRateLimiter(adapters.RateLimit{Rate: 0.5, Burst: 1}, nil)
+	interceptor := adapters.NewRateLimitUnaryServerInterceptor(limiter, "/grpc.health.v1.Health/Check")
+	for i := 0; i < 3; i++ {
+		resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "resp", nil
+			})
+		assert.Nil(t, err)
+		assert.Equal(t, "resp", resp)
+	}
+}
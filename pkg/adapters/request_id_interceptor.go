@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDKey is the metadata key of the request ID, set from the
+// X-Request-Id header by the annotator. Metadata keys are lower case.
+const requestIDKey = "corelationid"
+
+// NewRequestIDUnaryServerInterceptor returns a new unary server interceptor
+// which places the request ID in the incoming metadata on the context.
+func NewRequestIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+// NewRequestIDStreamServerInterceptor returns a new stream server interceptor
+// which places the request ID in the incoming metadata on the stream context.
+func NewRequestIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = withRequestID(stream.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDKey); len(values) > 0 && values[0] != "" {
+		return domain.NewContextWithRequestID(ctx, values[0])
+	}
+	return ctx
+}
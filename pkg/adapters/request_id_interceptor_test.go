@@ -0,0 +1,72 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewRequestIDUnaryServerInterceptor_PlacesRequestIDOnContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		expected string
+	}{
+		{
+			name:     "request id",
+			md:       metadata.New(map[string]string{"corelationID": "request"}),
+			expected: "request",
+		},
+		{
+			name:     "empty request id",
+			md:       metadata.New(map[string]string{"corelationID": ""}),
+			expected: "",
+		},
+		{
+			name:     "no metadata",
+			expected: "",
+		},
+	}
+
+	interceptor := adapters.NewRequestIDUnaryServerInterceptor()
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			ctx := context.Background()
+			if test.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, test.md)
+			}
+			var actual string
+			resp, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{FullMethod: "/BeerService/GetBeer"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					actual = domain.RequestIDFromContext(ctx)
+					return "resp", nil
+				})
+			assert.Nil(s, err)
+			assert.Equal(s, "resp", resp)
+			assert.Equal(s, test.expected, actual)
+		})
+	}
+}
+
+func TestNewRequestIDStreamServerInterceptor_PlacesRequestIDOnStreamContext(t *testing.T) {
+	t.Parallel()
+	interceptor := adapters.NewRequestIDStreamServerInterceptor()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"corelationID": "request"}))
+
+	var actual string
+	err := interceptor(nil, &serverStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/BeerService/ListBeers"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			actual = domain.RequestIDFromContext(stream.Context())
+			return nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "request", actual)
+}
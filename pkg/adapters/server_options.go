@@ -6,17 +6,37 @@ import (
 	"net/http"
 	"net/textproto"
 
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/instrumentation/grpctrace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 // NewProtoErrorHandler returns a new runtime.ProtoErrorHandlerFunc and
-// illustrates how custom responses can be returned from the grpc gateway.
+// illustrates how custom responses can be returned from the grpc gateway. The
+// grpc header and trailer metadata matched by the outgoing header matcher,
+// such as the rate limit headers, are returned as response headers.
 func NewProtoErrorHandler(logger *logrus.Logger) runtime.ProtoErrorHandlerFunc {
-	return func(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	outgoingHeaderMatcher := NewOutgoingHeaderMatcher()
+	return func(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			// Headers sent with an error arrive in the trailer metadata.
+			for _, m := range []metadata.MD{md.HeaderMD, md.TrailerMD} {
+				for k, vs := range m {
+					if h, ok := outgoingHeaderMatcher(k); ok {
+						for _, v := range vs {
+							w.Header().Add(h, v)
+						}
+					}
+				}
+			}
+		}
+
 		s, ok := status.FromError(err)
 		if !ok {
 			s = status.New(codes.Unknown, err.Error())
@@ -48,11 +68,16 @@ func NewProtoErrorHandler(logger *logrus.Logger) runtime.ProtoErrorHandlerFunc {
 
 // NewIncomingHeaderMatcher returns a new runtime.HeaderMatcherFunc and
 // illustrates how to match incoming request headers and add them to the grpc
-// metadata.
+// metadata. The Authorization and X-Api-Key headers are forwarded so that
+// callers of the REST API are authenticated by their bearer token or API key,
+// and the If-Match header so that updates and deletes can be conditional.
 func NewIncomingHeaderMatcher() runtime.HeaderMatcherFunc {
 	return func(key string) (string, bool) {
 		key = textproto.CanonicalMIMEHeaderKey(key)
-		if key == "X-Request-Id" {
+		if key == "X-Request-Id" ||
+			key == "Authorization" ||
+			key == "X-Api-Key" ||
+			key == "If-Match" {
 			return key, true
 		}
 
@@ -61,12 +86,18 @@ func NewIncomingHeaderMatcher() runtime.HeaderMatcherFunc {
 }
 
 // NewOutgoingHeaderMatcher returns a new runtime.HeaderMatcherFunc and
-// illustrates how to match outgoing response headers.
+// illustrates how to match outgoing response headers. The Retry-After and
+// RateLimit-* headers are returned so that callers of the REST API can back
+// off when rate limited.
 func NewOutgoingHeaderMatcher() runtime.HeaderMatcherFunc {
 	return func(key string) (string, bool) {
 		key = textproto.CanonicalMIMEHeaderKey(key)
 		if key == "Content-Type" ||
-			key == "Content-Length" {
+			key == "Content-Length" ||
+			key == "Retry-After" ||
+			key == "Ratelimit-Limit" ||
+			key == "Ratelimit-Remaining" ||
+			key == "Ratelimit-Reset" {
 			return key, true
 		}
 
@@ -74,11 +105,26 @@ func NewOutgoingHeaderMatcher() runtime.HeaderMatcherFunc {
 	}
 }
 
+// NewForwardResponseOption returns a new forward response option which sets
+// the ETag header of responses carrying a beer to the etag of the beer.
+func NewForwardResponseOption() func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+		if beer, ok := resp.(*beers.Beer); ok && beer.Etag != "" {
+			w.Header().Set("ETag", `"`+beer.Etag+`"`)
+		}
+		return nil
+	}
+}
+
 // NewAnnotator returns a new grpc metadata annotator and illustrates
-// how custom data can be added to the grpc metadata request context.
+// how custom data can be added to the grpc metadata request context. The W3C
+// trace context of the span in the request context is propagated in the grpc
+// metadata.
 func NewAnnotator() func(context.Context, *http.Request) metadata.MD {
 	return func(ctx context.Context, req *http.Request) metadata.MD {
 		xRequestID := req.Header.Get("X-Request-Id")
-		return metadata.New(map[string]string{"corelationID": xRequestID})
+		md := metadata.New(map[string]string{"corelationID": xRequestID})
+		grpctrace.Inject(ctx, &md)
+		return md
 	}
 }
@@ -10,9 +10,13 @@ import (
 	"testing"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/adapters"
+	"github.com/bvwells/grpc-gateway-example/proto/beers"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -27,7 +31,7 @@ func TestNewProtoErrorHandler_SetsResponseContentType(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	err := status.Error(codes.Internal, "something went wrong")
-	handler(nil, nil, nil, w, nil, err)
+	handler(context.Background(), nil, nil, w, nil, err)
 
 	assert.Equal(t, "application/json", w.Result().Header.Get("Content-Type"))
 }
@@ -41,7 +45,7 @@ func TestNewProtoErrorHandler_IfCalledWithStatusError_ReturnMappedHTTPError(t *t
 
 	w := httptest.NewRecorder()
 	err := status.Error(codes.NotFound, "something went wrong")
-	handler(nil, nil, nil, w, nil, err)
+	handler(context.Background(), nil, nil, w, nil, err)
 
 	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
 }
@@ -55,7 +59,7 @@ func TestNewProtoErrorHandler_IfCalledWithError_ReturnInternalServerError(t *tes
 
 	w := httptest.NewRecorder()
 	err := errors.New("something went wrong")
-	handler(nil, nil, nil, w, nil, err)
+	handler(context.Background(), nil, nil, w, nil, err)
 
 	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
 }
@@ -69,13 +73,35 @@ func TestNewProtoErrorHandler_ReturnsErrorBody(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	err := status.Error(codes.NotFound, "something went wrong")
-	handler(nil, nil, nil, w, nil, err)
+	handler(context.Background(), nil, nil, w, nil, err)
 
 	resp := w.Result()
 	body, _ := ioutil.ReadAll(resp.Body)
 	assert.Equal(t, "{\"code\":404,\"message\":\"something went wrong\"}", string(body))
 }
 
+func TestNewProtoErrorHandler_ReturnsMatchedServerMetadataHeaders(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := test.NewNullLogger()
+
+	handler := adapters.NewProtoErrorHandler(logger)
+
+	w := httptest.NewRecorder()
+	ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{
+		HeaderMD:  metadata.Pairs("ratelimit-limit", "10", "not-allowed", "value"),
+		TrailerMD: metadata.Pairs("retry-after", "2"),
+	})
+	err := status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	handler(ctx, nil, nil, w, nil, err)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "10", resp.Header.Get("RateLimit-Limit"))
+	assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+	assert.Equal(t, "", resp.Header.Get("Not-Allowed"))
+}
+
 func TestNewIncomingHeaderMatcher(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -96,6 +122,36 @@ func TestNewIncomingHeaderMatcher(t *testing.T) {
 			canonicalHeader: "X-Request-Id",
 			allowed:         true,
 		},
+		{
+			name:            "header is canonical authorization",
+			header:          "Authorization",
+			canonicalHeader: "Authorization",
+			allowed:         true,
+		},
+		{
+			name:            "header is not canonical authorization",
+			header:          "authorization",
+			canonicalHeader: "Authorization",
+			allowed:         true,
+		},
+		{
+			name:            "header is canonical x-api-key",
+			header:          "X-Api-Key",
+			canonicalHeader: "X-Api-Key",
+			allowed:         true,
+		},
+		{
+			name:            "header is not canonical x-api-key",
+			header:          "x-api-key",
+			canonicalHeader: "X-Api-Key",
+			allowed:         true,
+		},
+		{
+			name:            "header is not canonical if-match",
+			header:          "if-match",
+			canonicalHeader: "If-Match",
+			allowed:         true,
+		},
 		{
 			name:            "header is not allowed",
 			header:          "not-allowed",
@@ -145,6 +201,30 @@ func TestNewOutgoingHeaderMatcher(t *testing.T) {
 			canonicalHeader: "Content-Length",
 			allowed:         true,
 		},
+		{
+			name:            "header is retry-after",
+			header:          "retry-after",
+			canonicalHeader: "Retry-After",
+			allowed:         true,
+		},
+		{
+			name:            "header is ratelimit-limit",
+			header:          "ratelimit-limit",
+			canonicalHeader: "Ratelimit-Limit",
+			allowed:         true,
+		},
+		{
+			name:            "header is ratelimit-remaining",
+			header:          "ratelimit-remaining",
+			canonicalHeader: "Ratelimit-Remaining",
+			allowed:         true,
+		},
+		{
+			name:            "header is ratelimit-reset",
+			header:          "ratelimit-reset",
+			canonicalHeader: "Ratelimit-Reset",
+			allowed:         true,
+		},
 		{
 			name:            "header is not allowed",
 			header:          "not-allowed",
@@ -162,6 +242,38 @@ func TestNewOutgoingHeaderMatcher(t *testing.T) {
 	}
 }
 
+func TestNewForwardResponseOption_SetsETagOfBeers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		resp proto.Message
+		etag string
+	}{
+		{
+			name: "beer",
+			resp: &beers.Beer{Id: "id", Etag: "3"},
+			etag: `"3"`,
+		},
+		{
+			name: "beer without etag",
+			resp: &beers.Beer{Id: "id"},
+		},
+		{
+			name: "not a beer",
+			resp: &beers.ListBeersResponse{Beers: []*beers.Beer{{Id: "id", Etag: "3"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			w := httptest.NewRecorder()
+			err := adapters.NewForwardResponseOption()(context.Background(), w, test.resp)
+			assert.Nil(s, err)
+			assert.Equal(s, test.etag, w.Header().Get("ETag"))
+		})
+	}
+}
+
 func TestNewAnnotator(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -194,3 +306,17 @@ func TestNewAnnotator(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAnnotator_PropagatesTraceContext(t *testing.T) {
+	t.Parallel()
+	provider, err := sdktrace.NewProvider()
+	assert.Nil(t, err)
+	ctx, span := provider.Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+
+	md := adapters.NewAnnotator()(ctx, &http.Request{Header: http.Header{}})
+
+	sc := span.SpanContext()
+	expected := fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID)
+	assert.Equal(t, []string{expected}, md.Get("traceparent"))
+}
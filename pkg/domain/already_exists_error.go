@@ -0,0 +1,16 @@
+package domain
+
+// NewAlreadyExistsError returns a new AlreadyExistsError.
+func NewAlreadyExistsError(msg string) AlreadyExistsError {
+	return AlreadyExistsError{msg: msg}
+}
+
+// AlreadyExistsError is returned when an entity being created already exists.
+type AlreadyExistsError struct {
+	msg string
+}
+
+// Error returns the already exists error string.
+func (e AlreadyExistsError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlreadyExistsError_ReturnsAlreadyExistsError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewAlreadyExistsError("msg"))
+}
+
+func TestAlreadyExistsError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewAlreadyExistsError(expected)
+	assert.Equal(t, expected, err.Error())
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
+
+// APIKey is a key which authenticates a caller, such as a batch job, which
+// cannot obtain a bearer token. Only the hash of the key is stored, the key
+// itself is returned once when the API key is created.
+type APIKey struct {
+	ID   string
+	Name string
+	// Hash is the SHA-256 hash of the key, see HashAPIKey.
+	Hash []byte
+	// Scopes are the permissions granted to callers authenticated by the
+	// key.
+	Scopes     []Permission
+	CreateTime time.Time
+	ExpireTime time.Time
+	// RevokeTime is the time the key was revoked, nil if not revoked.
+	RevokeTime *time.Time
+}
+
+// Active returns true if the API key is neither expired nor revoked at the
+// given time.
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokeTime == nil && now.Before(k.ExpireTime)
+}
+
+// HashAPIKey returns the SHA-256 hash of the key. Keys are random with 256
+// bits of entropy, so a fast unsalted hash is sufficient to protect them.
+func HashAPIKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+// CreateAPIKeyParams describes parameters for creating an API key.
+type CreateAPIKeyParams struct {
+	Name       string
+	Scopes     []Permission
+	ExpireTime time.Time
+}
+
+// Validate validates the CreateAPIKeyParams.
+func (k *CreateAPIKeyParams) Validate() error {
+	if k.Name == "" {
+		return NewValidationError("api key name is empty")
+	}
+	if len(k.Scopes) == 0 {
+		return NewValidationError("api key scopes are empty")
+	}
+	for _, scope := range k.Scopes {
+		if !IsKnownPermission(scope) {
+			return NewValidationError(fmt.Sprintf("unknown api key scope '%s'", scope))
+		}
+	}
+	if k.ExpireTime.IsZero() {
+		return NewValidationError("api key expire time is empty")
+	}
+	return nil
+}
+
+// RevokeAPIKeyParams describes parameters for revoking an API key.
+type RevokeAPIKeyParams struct {
+	ID string
+}
+
+// Validate validates the RevokeAPIKeyParams.
+func (k *RevokeAPIKeyParams) Validate() error {
+	if k.ID == "" {
+		return NewValidationError("api key ID is empty")
+	}
+	return nil
+}
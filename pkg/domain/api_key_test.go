@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyActive(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	revoked := now.Add(-time.Minute)
+	tests := []struct {
+		name   string
+		key    *domain.APIKey
+		active bool
+	}{
+		{
+			name:   "active",
+			key:    &domain.APIKey{ExpireTime: now.Add(time.Hour)},
+			active: true,
+		},
+		{
+			name:   "expired",
+			key:    &domain.APIKey{ExpireTime: now},
+			active: false,
+		},
+		{
+			name:   "revoked",
+			key:    &domain.APIKey{ExpireTime: now.Add(time.Hour), RevokeTime: &revoked},
+			active: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.active, test.key.Active(now))
+		})
+	}
+}
+
+func TestHashAPIKey_ReturnsSHA256Hash(t *testing.T) {
+	t.Parallel()
+	expected := sha256.Sum256([]byte("key"))
+	assert.Equal(t, expected[:], domain.HashAPIKey("key"))
+	assert.NotEqual(t, domain.HashAPIKey("key"), domain.HashAPIKey("other"))
+}
+
+func TestCreateAPIKeyParamsValidate(t *testing.T) {
+	t.Parallel()
+	expireTime := time.Now().Add(time.Hour)
+	scopes := []domain.Permission{domain.PermissionListBeers}
+	tests := []struct {
+		name   string
+		params *domain.CreateAPIKeyParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.CreateAPIKeyParams{Name: "name", Scopes: scopes, ExpireTime: expireTime},
+			err:    nil,
+		},
+		{
+			name:   "missing name field",
+			params: &domain.CreateAPIKeyParams{Scopes: scopes, ExpireTime: expireTime},
+			err:    domain.NewValidationError("api key name is empty"),
+		},
+		{
+			name:   "missing scopes field",
+			params: &domain.CreateAPIKeyParams{Name: "name", ExpireTime: expireTime},
+			err:    domain.NewValidationError("api key scopes are empty"),
+		},
+		{
+			name:   "unknown scope",
+			params: &domain.CreateAPIKeyParams{Name: "name", Scopes: []domain.Permission{"beers.drink"}, ExpireTime: expireTime},
+			err:    domain.NewValidationError("unknown api key scope 'beers.drink'"),
+		},
+		{
+			name:   "missing expire time field",
+			params: &domain.CreateAPIKeyParams{Name: "name", Scopes: scopes},
+			err:    domain.NewValidationError("api key expire time is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestRevokeAPIKeyParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.RevokeAPIKeyParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.RevokeAPIKeyParams{ID: "ID"},
+			err:    nil,
+		},
+		{
+			name:   "missing id field",
+			params: &domain.RevokeAPIKeyParams{},
+			err:    domain.NewValidationError("api key ID is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
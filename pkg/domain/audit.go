@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// AuditAction is the kind of mutation of a beer recorded in an audit entry.
+type AuditAction string
+
+// The mutations of beers which are audited.
+const (
+	AuditActionCreate   AuditAction = "create"
+	AuditActionUpdate   AuditAction = "update"
+	AuditActionDelete   AuditAction = "delete"
+	AuditActionUndelete AuditAction = "undelete"
+)
+
+// AuditEntry records a mutation of a beer.
+type AuditEntry struct {
+	ID     string
+	BeerID string
+	Action AuditAction
+	// Actor is the subject of the principal which mutated the beer, empty
+	// if the caller was not authenticated.
+	Actor string
+	// RequestID is the ID of the request which mutated the beer, if any.
+	RequestID string
+	// Changes are the fields of the beer which were changed.
+	Changes    []FieldChange
+	CreateTime time.Time
+}
+
+// AuditRecord describes who mutates beers and when, so that repositories can
+// record the audit entries of the mutations in the same transaction as the
+// mutations.
+type AuditRecord struct {
+	// Actor is the subject of the principal mutating the beers, empty if the
+	// caller is not authenticated.
+	Actor string
+	// RequestID is the ID of the request mutating the beers, if any.
+	RequestID string
+	// Time is the time of the mutations.
+	Time time.Time
+}
+
+// NewAuditRecord returns the audit record of mutations at the given time by
+// the principal and request carried by the context.
+func NewAuditRecord(ctx context.Context, now time.Time) *AuditRecord {
+	record := &AuditRecord{RequestID: RequestIDFromContext(ctx), Time: now}
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		record.Actor = principal.Subject
+	}
+	return record
+}
+
+// Entry returns the audit entry of the mutation of a beer from before to
+// after. A nil before is a beer which did not exist.
+func (r *AuditRecord) Entry(action AuditAction, before, after *Beer) *AuditEntry {
+	return &AuditEntry{
+		BeerID:     after.ID,
+		Action:     action,
+		Actor:      r.Actor,
+		RequestID:  r.RequestID,
+		Changes:    DiffBeers(before, after),
+		CreateTime: r.Time,
+	}
+}
+
+// FieldChange is the change of the value of a field of a beer.
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// DiffBeers returns the changes of the fields of a beer from before to after.
+// A nil before is a beer which did not exist, so all the set fields of after
+// are changes.
+func DiffBeers(before, after *Beer) []FieldChange {
+	if before == nil {
+		before = &Beer{}
+	}
+	var changes []FieldChange
+	diff := func(field, before, after string) {
+		if before != after {
+			changes = append(changes, FieldChange{Field: field, Before: before, After: after})
+		}
+	}
+	beerType := func(t BeerType) string {
+		if s := t.String(); s != Unspecified.String() {
+			return s
+		}
+		return ""
+	}
+	float := func(f float64) string {
+		if f == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	integer := func(i int) string {
+		if i == 0 {
+			return ""
+		}
+		return strconv.Itoa(i)
+	}
+	deleteTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	diff("name", before.Name, after.Name)
+	diff("type", beerType(before.Type), beerType(after.Type))
+	diff("brewer", before.Brewer, after.Brewer)
+	diff("country", before.Country, after.Country)
+	diff("abv", float(before.ABV), float(after.ABV))
+	diff("ibu", integer(before.IBU), integer(after.IBU))
+	diff("srm", float(before.SRM), float(after.SRM))
+	diff("volume_ml", integer(before.VolumeML), integer(after.VolumeML))
+	diff("description", before.Description, after.Description)
+	diff("delete_time", deleteTime(before.DeleteTime), deleteTime(after.DeleteTime))
+	return changes
+}
+
+// ListBeerHistoryParams describes parameters for listing the history of a
+// beer.
+type ListBeerHistoryParams struct {
+	ID string
+}
+
+// Validate validates the ListBeerHistoryParams.
+func (b *ListBeerHistoryParams) Validate() error {
+	if b.ID == "" {
+		return NewValidationError("beer ID is empty")
+	}
+	return nil
+}
+
+type requestIDKey struct{}
+
+// NewContextWithRequestID returns a new context carrying the ID of the
+// request.
+func NewContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the ID of the request carried by the context,
+// or an empty string if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -0,0 +1,147 @@
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffBeers(t *testing.T) {
+	t.Parallel()
+	deleteTime := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+	beer := &domain.Beer{ID: "id", Name: "Orval", Type: domain.PaleAle, Brewer: "Orval", Country: "Belgium", Version: 1}
+	tests := []struct {
+		name    string
+		before  *domain.Beer
+		after   *domain.Beer
+		changes []domain.FieldChange
+	}{
+		{
+			name:   "created",
+			before: nil,
+			after:  beer,
+			changes: []domain.FieldChange{
+				{Field: "name", After: "Orval"},
+				{Field: "type", After: "PaleAle"},
+				{Field: "brewer", After: "Orval"},
+				{Field: "country", After: "Belgium"},
+			},
+		},
+		{
+			name:   "created without type",
+			before: nil,
+			after:  &domain.Beer{ID: "id", Name: "Orval", Type: domain.Unspecified},
+			changes: []domain.FieldChange{
+				{Field: "name", After: "Orval"},
+			},
+		},
+		{
+			name:    "unchanged",
+			before:  beer,
+			after:   &domain.Beer{ID: "id", Name: "Orval", Type: domain.PaleAle, Brewer: "Orval", Country: "Belgium", Version: 2},
+			changes: nil,
+		},
+		{
+			name:   "updated",
+			before: beer,
+			after:  &domain.Beer{ID: "id", Name: "Orval", Type: domain.Ale, Brewer: "Brasserie d'Orval", Country: "Belgium"},
+			changes: []domain.FieldChange{
+				{Field: "type", Before: "PaleAle", After: "Ale"},
+				{Field: "brewer", Before: "Orval", After: "Brasserie d'Orval"},
+			},
+		},
+		{
+			name:   "attributes updated",
+			before: &domain.Beer{ID: "id", Name: "Orval", ABV: 6.2, IBU: 32, VolumeML: 330},
+			after:  &domain.Beer{ID: "id", Name: "Orval", ABV: 6.9, IBU: 32, SRM: 10.5, Description: "Dry hopped."},
+			changes: []domain.FieldChange{
+				{Field: "abv", Before: "6.2", After: "6.9"},
+				{Field: "srm", After: "10.5"},
+				{Field: "volume_ml", Before: "330"},
+				{Field: "description", After: "Dry hopped."},
+			},
+		},
+		{
+			name:   "deleted",
+			before: beer,
+			after:  &domain.Beer{ID: "id", Name: "Orval", Type: domain.PaleAle, Brewer: "Orval", Country: "Belgium", DeleteTime: &deleteTime},
+			changes: []domain.FieldChange{
+				{Field: "delete_time", After: "2020-06-01T12:00:00Z"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.changes, domain.DiffBeers(test.before, test.after))
+		})
+	}
+}
+
+func TestListBeerHistoryParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.ListBeerHistoryParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.ListBeerHistoryParams{ID: "id"},
+			err:    nil,
+		},
+		{
+			name:   "missing id field",
+			params: &domain.ListBeerHistoryParams{},
+			err:    domain.NewValidationError("beer ID is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestRequestIDFromContext_ReturnsRequestID(t *testing.T) {
+	t.Parallel()
+	ctx := domain.NewContextWithRequestID(context.Background(), "request")
+	assert.Equal(t, "request", domain.RequestIDFromContext(ctx))
+}
+
+func TestRequestIDFromContext_WhenNoRequestID_ReturnsEmpty(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", domain.RequestIDFromContext(context.Background()))
+}
+
+func TestNewAuditRecord_ReturnsActorAndRequestIDOfContext(t *testing.T) {
+	t.Parallel()
+	ctx := domain.NewContextWithPrincipal(domain.NewContextWithRequestID(context.Background(), "request"),
+		&domain.Principal{Subject: "subject"})
+	now := time.Now()
+	expected := &domain.AuditRecord{Actor: "subject", RequestID: "request", Time: now}
+	assert.Equal(t, expected, domain.NewAuditRecord(ctx, now))
+}
+
+func TestAuditRecord_Entry_ReturnsEntryOfMutation(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	record := &domain.AuditRecord{Actor: "subject", RequestID: "request", Time: now}
+	before := &domain.Beer{ID: "id", Name: "before", Version: 1}
+	after := &domain.Beer{ID: "id", Name: "after", Version: 2}
+	expected := &domain.AuditEntry{
+		BeerID:     "id",
+		Action:     domain.AuditActionUpdate,
+		Actor:      "subject",
+		RequestID:  "request",
+		Changes:    []domain.FieldChange{{Field: "name", Before: "before", After: "after"}},
+		CreateTime: now,
+	}
+	assert.Equal(t, expected, record.Entry(domain.AuditActionUpdate, before, after))
+}
@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
 // Beer is a definition of a beer.
 type Beer struct {
 	ID      string
@@ -7,6 +13,24 @@ type Beer struct {
 	Type    BeerType
 	Brewer  string
 	Country string
+	// ABV is the alcohol by volume of the beer in percent, zero if not known.
+	ABV float64
+	// IBU is the bitterness of the beer in international bitterness units,
+	// zero if not known.
+	IBU int
+	// SRM is the colour of the beer on the standard reference method scale,
+	// zero if not known. The colour on the EBC scale is 1.97 times the SRM.
+	SRM float64
+	// VolumeML is the serving volume of the beer in millilitres, zero if not
+	// known.
+	VolumeML    int
+	Description string
+	// Version is incremented every time the beer is updated, starting from
+	// one when the beer is created.
+	Version int64
+	// DeleteTime is the time the beer was deleted, nil if not deleted.
+	// Deleted beers can be undeleted until they are purged.
+	DeleteTime *time.Time
 }
 
 // Validate validates a beer.
@@ -19,10 +43,15 @@ func (b *Beer) Validate() error {
 
 // CreateBeerParams describes parameters for creating a beer.
 type CreateBeerParams struct {
-	Name    string
-	Type    BeerType
-	Brewer  string
-	Country string
+	Name        string
+	Type        BeerType
+	Brewer      string
+	Country     string
+	ABV         float64
+	IBU         int
+	SRM         float64
+	VolumeML    int
+	Description string
 }
 
 // Validate validates the CreateBeerParams.
@@ -30,12 +59,45 @@ func (b *CreateBeerParams) Validate() error {
 	if b.Name == "" {
 		return NewValidationError("beer name is empty")
 	}
+	return validateAttributes(&b.ABV, &b.IBU, &b.SRM, &b.VolumeML, &b.Description)
+}
+
+// Limits of the attributes of beers.
+const (
+	MaxABV               = 100
+	MaxIBU               = 1000
+	MaxSRM               = 100
+	MaxVolumeML          = 10000
+	MaxDescriptionLength = 2000
+)
+
+// validateAttributes validates the attributes of a beer, skipping those which
+// are nil.
+func validateAttributes(abv *float64, ibu *int, srm *float64, volumeML *int, description *string) error {
+	// The float comparisons are negated so that NaN is out of range.
+	if abv != nil && !(*abv >= 0 && *abv <= MaxABV) {
+		return NewValidationError(fmt.Sprintf("beer ABV not between 0 and %d", MaxABV))
+	}
+	if ibu != nil && (*ibu < 0 || *ibu > MaxIBU) {
+		return NewValidationError(fmt.Sprintf("beer IBU not between 0 and %d", MaxIBU))
+	}
+	if srm != nil && !(*srm >= 0 && *srm <= MaxSRM) {
+		return NewValidationError(fmt.Sprintf("beer SRM not between 0 and %d", MaxSRM))
+	}
+	if volumeML != nil && (*volumeML < 0 || *volumeML > MaxVolumeML) {
+		return NewValidationError(fmt.Sprintf("beer volume not between 0 and %d ml", MaxVolumeML))
+	}
+	if description != nil && utf8.RuneCountInString(*description) > MaxDescriptionLength {
+		return NewValidationError(fmt.Sprintf("beer description longer than %d characters", MaxDescriptionLength))
+	}
 	return nil
 }
 
 // GetBeerParams describes parameters for getting a beer.
 type GetBeerParams struct {
 	ID string
+	// ShowDeleted gets the beer even if it is deleted.
+	ShowDeleted bool
 }
 
 // Validate validates the GetBeerParams.
@@ -48,11 +110,19 @@ func (b *GetBeerParams) Validate() error {
 
 // UpdateBeerParams describes parameters for updating a beer.
 type UpdateBeerParams struct {
-	ID      string
-	Name    *string
-	Type    *BeerType
-	Brewer  *string
-	Country *string
+	ID          string
+	Name        *string
+	Type        *BeerType
+	Brewer      *string
+	Country     *string
+	ABV         *float64
+	IBU         *int
+	SRM         *float64
+	VolumeML    *int
+	Description *string
+	// Version, if specified, is the version the beer must have to be
+	// updated, so that concurrent updates are not overwritten.
+	Version *int64
 }
 
 // Validate validates the UpdateBeerParams.
@@ -60,12 +130,15 @@ func (b *UpdateBeerParams) Validate() error {
 	if b.ID == "" {
 		return NewValidationError("beer ID is empty")
 	}
-	return nil
+	return validateAttributes(b.ABV, b.IBU, b.SRM, b.VolumeML, b.Description)
 }
 
 // DeleteBeerParams describes parameters for deleting a beer.
 type DeleteBeerParams struct {
 	ID string
+	// Version, if specified, is the version the beer must have to be
+	// deleted.
+	Version *int64
 }
 
 // Validate validates the DeleteBeerParams.
@@ -76,16 +149,104 @@ func (b *DeleteBeerParams) Validate() error {
 	return nil
 }
 
+// UndeleteBeerParams describes parameters for undeleting a beer.
+type UndeleteBeerParams struct {
+	ID string
+	// Version, if specified, is the version the beer must have to be
+	// undeleted.
+	Version *int64
+}
+
+// Validate validates the UndeleteBeerParams.
+func (b *UndeleteBeerParams) Validate() error {
+	if b.ID == "" {
+		return NewValidationError("beer ID is empty")
+	}
+	return nil
+}
+
+// Page sizes used when listing beers.
+const (
+	// DefaultPageSize is the page size used when none is specified.
+	DefaultPageSize = 100
+	// MaxPageSize is the largest page size, larger page sizes are coerced
+	// to this value.
+	MaxPageSize = 1000
+)
+
+// Cursor describes the position of a beer within an ordered list of beers.
+// It holds the values of every field beers can be ordered by, so that the
+// beers which follow it can be found without counting rows.
+type Cursor struct {
+	ID      string
+	Name    string
+	Type    BeerType
+	Brewer  string
+	Country string
+	// Score is the score of the beer when searching beers, which are ordered
+	// by score.
+	Score float64
+}
+
+// NewCursor returns a cursor positioned at the given beer.
+func NewCursor(b *Beer) *Cursor {
+	return &Cursor{
+		ID:      b.ID,
+		Name:    b.Name,
+		Type:    b.Type,
+		Brewer:  b.Brewer,
+		Country: b.Country,
+	}
+}
+
 // ListBeersParams describes parameters for listing beers.
 type ListBeersParams struct {
-	// Page is the page number of the beers.
-	Page int
+	// PageSize is the maximum number of beers to list.
+	PageSize int
+	// After lists the beers which follow the cursor if specified.
+	After *Cursor
+	// Type filters beers by type if specified.
+	Type *BeerType
+	// Brewer filters beers by brewer if not empty.
+	Brewer string
+	// Country filters beers by country if not empty.
+	Country string
+	// NamePrefix filters beers to those whose name starts with the prefix
+	// if not empty.
+	NamePrefix string
+	// OrderBy is the order by clause for the beers, see ParseOrderBy.
+	OrderBy string
+	// ShowDeleted lists deleted beers as well.
+	ShowDeleted bool
 }
 
 // Validate validates the ListBeersParams.
 func (b *ListBeersParams) Validate() error {
-	if b.Page < 1 {
-		return NewValidationError("page number less than one")
+	if b.PageSize < 0 {
+		return NewValidationError("page size less than zero")
+	}
+	if b.Type != nil && (*b.Type < Unspecified || *b.Type > PaleAle) {
+		return NewValidationError("invalid beer type")
+	}
+	if _, err := ParseOrderBy(b.OrderBy); err != nil {
+		return err
 	}
 	return nil
 }
+
+// ListBeersResult describes a page of listed beers.
+type ListBeersResult struct {
+	// Beers are the beers in the page.
+	Beers []*Beer
+	// Next is the cursor after which the next page of beers starts, nil if
+	// there are no more beers.
+	Next *Cursor
+	// TotalSize is the total number of beers matching the filters.
+	TotalSize int
+}
+
+// ExportBeersParams describes parameters for exporting every beer.
+type ExportBeersParams struct {
+	// ShowDeleted exports deleted beers as well.
+	ShowDeleted bool
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "fmt"
+
+// DefaultMaxBatchSize is the largest number of beers in a batch unless
+// configured otherwise.
+const DefaultMaxBatchSize = 100
+
+// BatchGetBeersParams describes parameters for getting a batch of beers.
+type BatchGetBeersParams struct {
+	// IDs are the IDs of the beers, which are got in the same order.
+	IDs []string
+}
+
+// Validate validates the BatchGetBeersParams.
+func (p *BatchGetBeersParams) Validate() error {
+	if len(p.IDs) == 0 {
+		return NewValidationError("no beers in batch")
+	}
+	for i, id := range p.IDs {
+		if id == "" {
+			return NewValidationError(fmt.Sprintf("ids[%d]: beer ID is empty", i))
+		}
+	}
+	return nil
+}
+
+// BatchCreateBeersParams describes parameters for creating a batch of beers.
+type BatchCreateBeersParams struct {
+	Beers []*CreateBeerParams
+}
+
+// Validate validates the BatchCreateBeersParams.
+func (p *BatchCreateBeersParams) Validate() error {
+	if len(p.Beers) == 0 {
+		return NewValidationError("no beers in batch")
+	}
+	for i, beer := range p.Beers {
+		if err := beer.Validate(); err != nil {
+			return NewValidationError(fmt.Sprintf("requests[%d]: %s", i, err))
+		}
+	}
+	return nil
+}
+
+// BatchDeleteBeersParams describes parameters for deleting a batch of beers.
+type BatchDeleteBeersParams struct {
+	Beers []*DeleteBeerParams
+}
+
+// Validate validates the BatchDeleteBeersParams. A beer can only be deleted
+// once in a batch.
+func (p *BatchDeleteBeersParams) Validate() error {
+	if len(p.Beers) == 0 {
+		return NewValidationError("no beers in batch")
+	}
+	ids := make(map[string]bool, len(p.Beers))
+	for i, beer := range p.Beers {
+		if err := beer.Validate(); err != nil {
+			return NewValidationError(fmt.Sprintf("requests[%d]: %s", i, err))
+		}
+		if ids[beer.ID] {
+			return NewValidationError(fmt.Sprintf("requests[%d]: beer '%s' is already in batch", i, beer.ID))
+		}
+		ids[beer.ID] = true
+	}
+	return nil
+}
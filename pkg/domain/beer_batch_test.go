@@ -0,0 +1,108 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchGetBeersParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.BatchGetBeersParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.BatchGetBeersParams{IDs: []string{"a", "b", "a"}},
+			err:    nil,
+		},
+		{
+			name:   "no ids",
+			params: &domain.BatchGetBeersParams{},
+			err:    domain.NewValidationError("no beers in batch"),
+		},
+		{
+			name:   "empty id",
+			params: &domain.BatchGetBeersParams{IDs: []string{"a", ""}},
+			err:    domain.NewValidationError("ids[1]: beer ID is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestBatchCreateBeersParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.BatchCreateBeersParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}, {Name: "a"}}},
+			err:    nil,
+		},
+		{
+			name:   "no beers",
+			params: &domain.BatchCreateBeersParams{},
+			err:    domain.NewValidationError("no beers in batch"),
+		},
+		{
+			name:   "invalid beer",
+			params: &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}, {}}},
+			err:    domain.NewValidationError("requests[1]: beer name is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestBatchDeleteBeersParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.BatchDeleteBeersParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {ID: "b"}}},
+			err:    nil,
+		},
+		{
+			name:   "no beers",
+			params: &domain.BatchDeleteBeersParams{},
+			err:    domain.NewValidationError("no beers in batch"),
+		},
+		{
+			name:   "invalid beer",
+			params: &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {}}},
+			err:    domain.NewValidationError("requests[1]: beer ID is empty"),
+		},
+		{
+			name:   "duplicate beer",
+			params: &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {ID: "b"}, {ID: "a"}}},
+			err:    domain.NewValidationError("requests[2]: beer 'a' is already in batch"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
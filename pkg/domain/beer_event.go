@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// BeerEventType is the kind of change of a beer described by a beer event.
+type BeerEventType int
+
+// The kinds of change of a beer. Undeleting a beer is an update of the beer.
+const (
+	BeerCreated BeerEventType = iota + 1
+	BeerUpdated
+	BeerDeleted
+)
+
+// BeerEvent describes a change of a beer.
+type BeerEvent struct {
+	Type BeerEventType
+	// Beer is the beer after the change, deleted beers have a delete time.
+	Beer       *Beer
+	CreateTime time.Time
+	// ResumeToken is set when the event is published, watches resumed from
+	// the token start after the event.
+	ResumeToken string
+}
+
+// WatchBeersParams describes parameters for watching the events of beers.
+type WatchBeersParams struct {
+	// ResumeToken, if not empty, is the resume token of the last event the
+	// caller received. Without a resume token only the events published
+	// after the watch starts are watched.
+	ResumeToken string
+}
@@ -0,0 +1,84 @@
+package domain
+
+import "fmt"
+
+const (
+	// ImportBatchSize is the largest number of beers imported together,
+	// every batch of an import is committed on its own.
+	ImportBatchSize = 100
+	// maxBeerIDLength is the longest ID of an imported beer.
+	maxBeerIDLength = 36
+)
+
+// ImportBeersParams describes parameters for importing a batch of beers.
+type ImportBeersParams struct {
+	// Beers are the beers to import. Beers without an ID are given one and
+	// every beer is imported with version one, whatever its version.
+	Beers []*Beer
+}
+
+// Validate validates the ImportBeersParams. The beers themselves are
+// validated one by one with ValidateImportedBeer so that invalid beers fail
+// without failing the rest of the batch.
+func (p *ImportBeersParams) Validate() error {
+	if len(p.Beers) > ImportBatchSize {
+		return NewValidationError(fmt.Sprintf("more than %d beers in batch", ImportBatchSize))
+	}
+	return nil
+}
+
+// ValidateImportedBeer validates a beer to be imported.
+func ValidateImportedBeer(b *Beer) error {
+	if len(b.ID) > maxBeerIDLength {
+		return NewValidationError(fmt.Sprintf("beer ID longer than %d characters", maxBeerIDLength))
+	}
+	if b.Name == "" {
+		return NewValidationError("beer name is empty")
+	}
+	if b.Type < Unspecified || b.Type > PaleAle {
+		return NewValidationError("invalid beer type")
+	}
+	return validateAttributes(&b.ABV, &b.IBU, &b.SRM, &b.VolumeML, &b.Description)
+}
+
+// ImportError describes a beer which failed to be imported.
+type ImportError struct {
+	// Index is the index of the beer in the import.
+	Index int
+	// ID is the ID of the beer, empty if the beer has no ID.
+	ID      string
+	Message string
+}
+
+// ImportBeersResult summarises an import of beers.
+type ImportBeersResult struct {
+	// Created is the number of beers created.
+	Created int
+	// Skipped is the number of beers not imported as a beer with the same
+	// ID already exists, so that an import can be retried.
+	Skipped int
+	// Failed is the number of beers which failed to be imported.
+	Failed int
+	// Errors are the errors of the beers which failed to be imported.
+	Errors []ImportError
+}
+
+// Add adds the result of importing a batch of an import to the result. The
+// indices of the errors of the batch, which are indices in the batch, are
+// mapped to indices in the import with the indices of the beers of the
+// batch in the import.
+func (r *ImportBeersResult) Add(batch *ImportBeersResult, indices []int) {
+	r.Created += batch.Created
+	r.Skipped += batch.Skipped
+	r.Failed += batch.Failed
+	for _, e := range batch.Errors {
+		e.Index = indices[e.Index]
+		r.Errors = append(r.Errors, e)
+	}
+}
+
+// Fail adds a beer of the import which failed to be imported to the result.
+func (r *ImportBeersResult) Fail(index int, id string, err error) {
+	r.Failed++
+	r.Errors = append(r.Errors, ImportError{Index: index, ID: id, Message: err.Error()})
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportBeersParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.ImportBeersParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.ImportBeersParams{Beers: make([]*domain.Beer, domain.ImportBatchSize)},
+			err:    nil,
+		},
+		{
+			name:   "too many beers",
+			params: &domain.ImportBeersParams{Beers: make([]*domain.Beer, domain.ImportBatchSize+1)},
+			err:    domain.NewValidationError("more than 100 beers in batch"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestValidateImportedBeer(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		beer *domain.Beer
+		err  error
+	}{
+		{
+			name: "all good",
+			beer: &domain.Beer{ID: strings.Repeat("a", 36), Name: "name", Type: domain.PaleAle},
+			err:  nil,
+		},
+		{
+			name: "missing id field",
+			beer: &domain.Beer{Name: "name", Type: domain.Ale},
+			err:  nil,
+		},
+		{
+			name: "id too long",
+			beer: &domain.Beer{ID: strings.Repeat("a", 37), Name: "name", Type: domain.Ale},
+			err:  domain.NewValidationError("beer ID longer than 36 characters"),
+		},
+		{
+			name: "missing name field",
+			beer: &domain.Beer{ID: "ID", Type: domain.Ale},
+			err:  domain.NewValidationError("beer name is empty"),
+		},
+		{
+			name: "invalid type",
+			beer: &domain.Beer{ID: "ID", Name: "name"},
+			err:  domain.NewValidationError("invalid beer type"),
+		},
+		{
+			name: "invalid attribute",
+			beer: &domain.Beer{ID: "ID", Name: "name", Type: domain.Ale, ABV: 5, IBU: 2000},
+			err:  domain.NewValidationError("beer IBU not between 0 and 1000"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, domain.ValidateImportedBeer(test.beer))
+		})
+	}
+}
+
+func TestImportBeersResult_Add_MapsErrorIndicesToImport(t *testing.T) {
+	t.Parallel()
+	result := &domain.ImportBeersResult{
+		Created: 1,
+		Failed:  1,
+		Errors:  []domain.ImportError{{Index: 1, ID: "a", Message: "a failed"}},
+	}
+	result.Add(&domain.ImportBeersResult{
+		Created: 2,
+		Skipped: 1,
+		Failed:  1,
+		Errors:  []domain.ImportError{{Index: 1, ID: "b", Message: "b failed"}},
+	}, []int{2, 4, 5})
+
+	assert.Equal(t, &domain.ImportBeersResult{
+		Created: 3,
+		Skipped: 1,
+		Failed:  2,
+		Errors: []domain.ImportError{
+			{Index: 1, ID: "a", Message: "a failed"},
+			{Index: 4, ID: "b", Message: "b failed"},
+		},
+	}, result)
+}
+
+func TestImportBeersResult_Fail_AddsFailedBeer(t *testing.T) {
+	t.Parallel()
+	result := &domain.ImportBeersResult{Created: 1}
+	result.Fail(3, "a", errors.New("a failed"))
+
+	assert.Equal(t, &domain.ImportBeersResult{
+		Created: 1,
+		Failed:  1,
+		Errors:  []domain.ImportError{{Index: 3, ID: "a", Message: "a failed"}},
+	}, result)
+}
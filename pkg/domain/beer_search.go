@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSearchQueryLength is the largest number of characters in a search
+	// query.
+	MaxSearchQueryLength = 200
+	// SimilarityThreshold is the trigram similarity above which a word is
+	// taken to be a misspelling of a search term, the default similarity
+	// threshold of the postgres pg_trgm extension.
+	SimilarityThreshold = 0.3
+)
+
+// Markers of the highlighted words of search snippets.
+const (
+	HighlightStart = "<b>"
+	HighlightEnd   = "</b>"
+)
+
+// SearchBeersParams describes parameters for searching beers.
+type SearchBeersParams struct {
+	// Query is the text to search the names, brewers and countries of beers
+	// for.
+	Query string
+	// PageSize is the maximum number of beers to return.
+	PageSize int
+	// After searches the beers which follow the cursor if specified. The
+	// cursor holds the score of the beer it is positioned at.
+	After *Cursor
+}
+
+// Validate validates the SearchBeersParams.
+func (p *SearchBeersParams) Validate() error {
+	if utf8.RuneCountInString(p.Query) > MaxSearchQueryLength {
+		return NewValidationError(fmt.Sprintf("search query longer than %d characters", MaxSearchQueryLength))
+	}
+	if len(SearchTerms(p.Query)) == 0 {
+		return NewValidationError("search query is empty")
+	}
+	if p.PageSize < 0 {
+		return NewValidationError("page size less than zero")
+	}
+	return nil
+}
+
+// SearchResult describes a beer matching a search.
+type SearchResult struct {
+	Beer *Beer
+	// Score is the rank of the beer, higher scores match the search better.
+	Score float64
+	// Snippets are the fields of the beer with the words matching the search
+	// highlighted.
+	Snippets []*Snippet
+}
+
+// Snippet describes a field of a beer with the words matching a search
+// highlighted between HighlightStart and HighlightEnd. The text is HTML
+// escaped.
+type Snippet struct {
+	Field string
+	Text  string
+}
+
+// SearchBeersResult describes a page of beers matching a search, in order of
+// descending score.
+type SearchBeersResult struct {
+	Results []*SearchResult
+	// Next is the cursor after which the next page of results starts, nil if
+	// there are no more results.
+	Next *Cursor
+}
+
+// SearchTerms returns the distinct lower case words of the search query in
+// the order they appear. Words are runs of letters and digits.
+func SearchTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.FieldsFunc(strings.ToLower(query), isNotWordRune) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// MatchTerm returns how well the lower case word matches the search term,
+// between 0 for no match and 1 for the term itself. Words starting with the
+// term score the fraction of the word typed, and words similar to the term
+// score their trigram similarity, so partial and misspelled terms match.
+func MatchTerm(word, term string) float64 {
+	score := 0.0
+	if strings.HasPrefix(word, term) {
+		score = float64(utf8.RuneCountInString(term)) / float64(utf8.RuneCountInString(word))
+	}
+	if similarity := Similarity(word, term); similarity >= SimilarityThreshold && similarity > score {
+		score = similarity
+	}
+	return score
+}
+
+// Similarity returns the trigram similarity of two strings as defined by the
+// postgres pg_trgm extension, the number of trigrams the strings share over
+// the number of distinct trigrams of both strings.
+func Similarity(a, b string) float64 {
+	ta, tb := trigrams(a), trigrams(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// trigrams returns the trigrams of the words of the string, each word padded
+// with two spaces before and one after as by pg_trgm.
+func trigrams(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(s), isNotWordRune) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// Highlight returns the HTML escaped text with the words matching any of the
+// search terms highlighted, and whether any word was highlighted.
+func Highlight(text string, terms []string) (string, bool) {
+	var (
+		b           strings.Builder
+		highlighted bool
+	)
+	for len(text) > 0 {
+		end := strings.IndexFunc(text, isWordRune)
+		if end < 0 {
+			end = len(text)
+		}
+		b.WriteString(html.EscapeString(text[:end]))
+		text = text[end:]
+
+		end = strings.IndexFunc(text, isNotWordRune)
+		if end < 0 {
+			end = len(text)
+		}
+		word := text[:end]
+		text = text[end:]
+		if word == "" {
+			continue
+		}
+		if matchesAnyTerm(strings.ToLower(word), terms) {
+			highlighted = true
+			b.WriteString(HighlightStart + html.EscapeString(word) + HighlightEnd)
+		} else {
+			b.WriteString(html.EscapeString(word))
+		}
+	}
+	return b.String(), highlighted
+}
+
+// NewSnippets returns snippets of the name, brewer and country of the beer
+// with the words matching the search terms highlighted, omitting fields
+// without matching words.
+func NewSnippets(beer *Beer, terms []string) []*Snippet {
+	var snippets []*Snippet
+	for _, field := range []struct {
+		name string
+		text string
+	}{
+		{name: "name", text: beer.Name},
+		{name: "brewer", text: beer.Brewer},
+		{name: "country", text: beer.Country},
+	} {
+		if text, ok := Highlight(field.text, terms); ok {
+			snippets = append(snippets, &Snippet{Field: field.name, Text: text})
+		}
+	}
+	return snippets
+}
+
+func matchesAnyTerm(word string, terms []string) bool {
+	for _, term := range terms {
+		if MatchTerm(word, term) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isNotWordRune(r rune) bool {
+	return !isWordRune(r)
+}
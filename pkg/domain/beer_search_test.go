@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchBeersParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.SearchBeersParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.SearchBeersParams{Query: "orval", PageSize: 10},
+			err:    nil,
+		},
+		{
+			name:   "empty query",
+			params: &domain.SearchBeersParams{},
+			err:    domain.NewValidationError("search query is empty"),
+		},
+		{
+			name:   "query without words",
+			params: &domain.SearchBeersParams{Query: " - & "},
+			err:    domain.NewValidationError("search query is empty"),
+		},
+		{
+			name:   "query too long",
+			params: &domain.SearchBeersParams{Query: strings.Repeat("é", 201)},
+			err:    domain.NewValidationError("search query longer than 200 characters"),
+		},
+		{
+			name:   "negative page size",
+			params: &domain.SearchBeersParams{Query: "orval", PageSize: -1},
+			err:    domain.NewValidationError("page size less than zero"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestSearchTerms_ReturnsDistinctLowerCaseWords(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"chimay", "bleue", "9", "brasserie", "d"},
+		domain.SearchTerms("Chimay  BLEUE-9, chimay & Brasserie d"))
+	assert.Equal(t, []string{"bière", "de", "garde"}, domain.SearchTerms("Bière de Garde"))
+	assert.Empty(t, domain.SearchTerms(" ? "))
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{a: "cat", b: "cat", expected: 1},
+		{a: "cat", b: "CATS", expected: 0.5},
+		{a: "cat", b: "dog", expected: 0},
+		{a: "cat", b: "", expected: 0},
+		{a: "chimay", b: "chimey", expected: 0.4},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s %s", test.a, test.b), func(s *testing.T) {
+			assert.InDelta(s, test.expected, domain.Similarity(test.a, test.b), 1e-9)
+		})
+	}
+}
+
+func TestMatchTerm(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		word     string
+		term     string
+		expected float64
+	}{
+		{name: "same word", word: "orval", term: "orval", expected: 1},
+		{name: "prefix", word: "orval", term: "or", expected: 0.4},
+		{name: "misspelling", word: "chimay", term: "chimey", expected: 0.4},
+		{name: "longer prefix", word: "orval", term: "orv", expected: 0.6},
+		{name: "no match", word: "orval", term: "stout", expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.InDelta(s, test.expected, domain.MatchTerm(test.word, test.term), 1e-9)
+		})
+	}
+}
+
+func TestHighlight_HighlightsMatchingWords(t *testing.T) {
+	t.Parallel()
+	text, ok := domain.Highlight("Chimay Bleue & <Chimey>", []string{"chimay", "ble"})
+	assert.True(t, ok)
+	assert.Equal(t, "<b>Chimay</b> <b>Bleue</b> &amp; &lt;<b>Chimey</b>&gt;", text)
+
+	text, ok = domain.Highlight("Orval & co", []string{"chimay"})
+	assert.False(t, ok)
+	assert.Equal(t, "Orval &amp; co", text)
+}
+
+func TestNewSnippets_ReturnsMatchingFields(t *testing.T) {
+	t.Parallel()
+	beer := &domain.Beer{Name: "Westmalle Tripel", Brewer: "Westmalle", Country: "Belgium"}
+	assert.Equal(t, []*domain.Snippet{
+		{Field: "name", Text: "<b>Westmalle</b> Tripel"},
+		{Field: "brewer", Text: "<b>Westmalle</b>"},
+	}, domain.NewSnippets(beer, []string{"westm"}))
+	assert.Equal(t, []*domain.Snippet{
+		{Field: "country", Text: "<b>Belgium</b>"},
+	}, domain.NewSnippets(beer, []string{"belgum"}))
+	assert.Empty(t, domain.NewSnippets(beer, []string{"stout"}))
+}
@@ -2,6 +2,8 @@ package domain_test
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
@@ -52,6 +54,42 @@ func TestCreateBeerParamsValidate(t *testing.T) {
 			params: &domain.CreateBeerParams{},
 			err:    domain.NewValidationError("beer name is empty"),
 		},
+		{
+			name: "attributes at limits",
+			params: &domain.CreateBeerParams{Name: "name", ABV: 100, IBU: 1000, SRM: 100, VolumeML: 10000,
+				Description: strings.Repeat("é", 2000)},
+			err: nil,
+		},
+		{
+			name:   "negative abv",
+			params: &domain.CreateBeerParams{Name: "name", ABV: -0.1},
+			err:    domain.NewValidationError("beer ABV not between 0 and 100"),
+		},
+		{
+			name:   "abv not a number",
+			params: &domain.CreateBeerParams{Name: "name", ABV: math.NaN()},
+			err:    domain.NewValidationError("beer ABV not between 0 and 100"),
+		},
+		{
+			name:   "ibu too large",
+			params: &domain.CreateBeerParams{Name: "name", IBU: 1001},
+			err:    domain.NewValidationError("beer IBU not between 0 and 1000"),
+		},
+		{
+			name:   "srm too large",
+			params: &domain.CreateBeerParams{Name: "name", SRM: 100.5},
+			err:    domain.NewValidationError("beer SRM not between 0 and 100"),
+		},
+		{
+			name:   "negative volume",
+			params: &domain.CreateBeerParams{Name: "name", VolumeML: -330},
+			err:    domain.NewValidationError("beer volume not between 0 and 10000 ml"),
+		},
+		{
+			name:   "description too long",
+			params: &domain.CreateBeerParams{Name: "name", Description: strings.Repeat("a", 2001)},
+			err:    domain.NewValidationError("beer description longer than 2000 characters"),
+		},
 	}
 
 	for _, test := range tests {
@@ -104,6 +142,36 @@ func TestUpdateBeerParamsValidate(t *testing.T) {
 			params: &domain.UpdateBeerParams{},
 			err:    domain.NewValidationError("beer ID is empty"),
 		},
+		{
+			name:   "attributes in range",
+			params: &domain.UpdateBeerParams{ID: "id", ABV: float64Ptr(0), IBU: intPtr(0), SRM: float64Ptr(0), VolumeML: intPtr(0)},
+			err:    nil,
+		},
+		{
+			name:   "abv out of range",
+			params: &domain.UpdateBeerParams{ID: "id", ABV: float64Ptr(101)},
+			err:    domain.NewValidationError("beer ABV not between 0 and 100"),
+		},
+		{
+			name:   "ibu out of range",
+			params: &domain.UpdateBeerParams{ID: "id", IBU: intPtr(-1)},
+			err:    domain.NewValidationError("beer IBU not between 0 and 1000"),
+		},
+		{
+			name:   "srm out of range",
+			params: &domain.UpdateBeerParams{ID: "id", SRM: float64Ptr(-1)},
+			err:    domain.NewValidationError("beer SRM not between 0 and 100"),
+		},
+		{
+			name:   "volume out of range",
+			params: &domain.UpdateBeerParams{ID: "id", VolumeML: intPtr(10001)},
+			err:    domain.NewValidationError("beer volume not between 0 and 10000 ml"),
+		},
+		{
+			name:   "description too long",
+			params: &domain.UpdateBeerParams{ID: "id", Description: stringPtr(strings.Repeat("a", 2001))},
+			err:    domain.NewValidationError("beer description longer than 2000 characters"),
+		},
 	}
 
 	for _, test := range tests {
@@ -139,8 +207,43 @@ func TestDeleteBeerParamsValidate(t *testing.T) {
 	}
 }
 
+func TestUndeleteBeerParamsValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params *domain.UndeleteBeerParams
+		err    error
+	}{
+		{
+			name:   "all good",
+			params: &domain.UndeleteBeerParams{ID: "id"},
+			err:    nil,
+		},
+		{
+			name:   "missing id field",
+			params: &domain.UndeleteBeerParams{},
+			err:    domain.NewValidationError("beer ID is empty"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.err, test.params.Validate())
+		})
+	}
+}
+
+func TestNewCursor(t *testing.T) {
+	t.Parallel()
+	beer := &domain.Beer{ID: "id", Name: "name", Type: domain.Porter, Brewer: "brewer", Country: "country"}
+	expected := &domain.Cursor{ID: "id", Name: "name", Type: domain.Porter, Brewer: "brewer", Country: "country"}
+	assert.Equal(t, expected, domain.NewCursor(beer))
+}
+
 func TestListBeersParamsValidate(t *testing.T) {
 	t.Parallel()
+	stout := domain.Stout
+	invalidType := domain.BeerType(100)
 	tests := []struct {
 		name   string
 		params *domain.ListBeersParams
@@ -148,13 +251,33 @@ func TestListBeersParamsValidate(t *testing.T) {
 	}{
 		{
 			name:   "all good",
-			params: &domain.ListBeersParams{Page: 10},
+			params: &domain.ListBeersParams{PageSize: 10},
+			err:    nil,
+		},
+		{
+			name:   "default page size",
+			params: &domain.ListBeersParams{},
+			err:    nil,
+		},
+		{
+			name:   "invalid page size",
+			params: &domain.ListBeersParams{PageSize: -1},
+			err:    domain.NewValidationError("page size less than zero"),
+		},
+		{
+			name:   "all filters",
+			params: &domain.ListBeersParams{Type: &stout, Brewer: "brewer", Country: "country", NamePrefix: "prefix", OrderBy: "name desc"},
 			err:    nil,
 		},
 		{
-			name:   "invalid page number",
-			params: &domain.ListBeersParams{Page: 0},
-			err:    domain.NewValidationError("page number less than one"),
+			name:   "invalid type",
+			params: &domain.ListBeersParams{Type: &invalidType},
+			err:    domain.NewValidationError("invalid beer type"),
+		},
+		{
+			name:   "invalid order by",
+			params: &domain.ListBeersParams{OrderBy: "colour"},
+			err:    domain.NewValidationError("invalid order by field: 'colour'"),
 		},
 	}
 
@@ -164,3 +287,15 @@ func TestListBeersParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,17 @@
+package domain
+
+// NewConflictError returns a new ConflictError.
+func NewConflictError(msg string) ConflictError {
+	return ConflictError{msg: msg}
+}
+
+// ConflictError is returned when an operation conflicts with a concurrent
+// modification of an entity.
+type ConflictError struct {
+	msg string
+}
+
+// Error returns the conflict error string.
+func (e ConflictError) Error() string {
+	return e.msg
+}
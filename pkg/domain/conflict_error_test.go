@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConflictError_ReturnsConflictError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewConflictError("msg"))
+}
+
+func TestConflictError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewConflictError(expected)
+	assert.Equal(t, expected, err.Error())
+}
@@ -0,0 +1,17 @@
+package domain
+
+// NewFailedPreconditionError returns a new FailedPreconditionError.
+func NewFailedPreconditionError(msg string) FailedPreconditionError {
+	return FailedPreconditionError{msg: msg}
+}
+
+// FailedPreconditionError is returned when an entity is not in the state required
+// for an operation.
+type FailedPreconditionError struct {
+	msg string
+}
+
+// Error returns the failed precondition error string.
+func (e FailedPreconditionError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFailedPreconditionError_ReturnsFailedPreconditionError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewFailedPreconditionError("msg"))
+}
+
+func TestFailedPreconditionError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewFailedPreconditionError(expected)
+	assert.Equal(t, expected, err.Error())
+}
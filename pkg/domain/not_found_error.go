@@ -0,0 +1,16 @@
+package domain
+
+// NewNotFoundError returns a new NotFoundError.
+func NewNotFoundError(msg string) NotFoundError {
+	return NotFoundError{msg: msg}
+}
+
+// NotFoundError is returned when a requested entity does not exist.
+type NotFoundError struct {
+	msg string
+}
+
+// Error returns the not found error string.
+func (e NotFoundError) Error() string {
+	return e.msg
+}
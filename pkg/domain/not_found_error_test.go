@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotFoundError_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewNotFoundError("msg"))
+}
+
+func TestNotFoundError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewNotFoundError(expected)
+	assert.Equal(t, expected, err.Error())
+}
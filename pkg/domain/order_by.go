@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Fields by which beers can be ordered.
+const (
+	OrderByID      = "id"
+	OrderByName    = "name"
+	OrderByType    = "type"
+	OrderByBrewer  = "brewer"
+	OrderByCountry = "country"
+)
+
+// OrderBy describes a field to order beers by.
+type OrderBy struct {
+	// Field is the name of the field to order by.
+	Field string
+	// Descending is true if the field is ordered in descending order.
+	Descending bool
+}
+
+// ParseOrderBy parses an order by clause of the form "name desc,brewer" into
+// a list of fields to order by. Each field can optionally be followed by
+// "asc" or "desc" and fields can only be specified once.
+func ParseOrderBy(in string) ([]OrderBy, error) {
+	if strings.TrimSpace(in) == "" {
+		return nil, nil
+	}
+
+	var orderBy []OrderBy
+	seen := make(map[string]bool)
+	for _, clause := range strings.Split(in, ",") {
+		parts := strings.Fields(strings.ToLower(clause))
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, NewValidationError(fmt.Sprintf("invalid order by clause: '%s'", strings.TrimSpace(clause)))
+		}
+
+		field := parts[0]
+		switch field {
+		case OrderByID, OrderByName, OrderByType, OrderByBrewer, OrderByCountry:
+		default:
+			return nil, NewValidationError(fmt.Sprintf("invalid order by field: '%s'", field))
+		}
+		if seen[field] {
+			return nil, NewValidationError(fmt.Sprintf("duplicate order by field: '%s'", field))
+		}
+		seen[field] = true
+
+		descending := false
+		if len(parts) == 2 {
+			switch parts[1] {
+			case "asc":
+			case "desc":
+				descending = true
+			default:
+				return nil, NewValidationError(fmt.Sprintf("invalid order by direction: '%s'", parts[1]))
+			}
+		}
+		orderBy = append(orderBy, OrderBy{Field: field, Descending: descending})
+	}
+	return orderBy, nil
+}
@@ -0,0 +1,93 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		in      string
+		orderBy []domain.OrderBy
+		err     error
+	}{
+		{
+			name:    "empty clause",
+			in:      "",
+			orderBy: nil,
+			err:     nil,
+		},
+		{
+			name:    "whitespace clause",
+			in:      "  ",
+			orderBy: nil,
+			err:     nil,
+		},
+		{
+			name:    "single field",
+			in:      "name",
+			orderBy: []domain.OrderBy{{Field: "name"}},
+			err:     nil,
+		},
+		{
+			name: "multiple fields with directions",
+			in:   "name desc, brewer asc,country",
+			orderBy: []domain.OrderBy{
+				{Field: "name", Descending: true},
+				{Field: "brewer"},
+				{Field: "country"},
+			},
+			err: nil,
+		},
+		{
+			name:    "mixed case",
+			in:      "Type DESC",
+			orderBy: []domain.OrderBy{{Field: "type", Descending: true}},
+			err:     nil,
+		},
+		{
+			name:    "unknown field",
+			in:      "colour",
+			orderBy: nil,
+			err:     domain.NewValidationError("invalid order by field: 'colour'"),
+		},
+		{
+			name:    "unknown direction",
+			in:      "name sideways",
+			orderBy: nil,
+			err:     domain.NewValidationError("invalid order by direction: 'sideways'"),
+		},
+		{
+			name:    "duplicate field",
+			in:      "name,name desc",
+			orderBy: nil,
+			err:     domain.NewValidationError("duplicate order by field: 'name'"),
+		},
+		{
+			name:    "empty clause between commas",
+			in:      "name,,brewer",
+			orderBy: nil,
+			err:     domain.NewValidationError("invalid order by clause: ''"),
+		},
+		{
+			name:    "too many parts",
+			in:      "name desc please",
+			orderBy: nil,
+			err:     domain.NewValidationError("invalid order by clause: 'name desc please'"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			orderBy, err := domain.ParseOrderBy(test.in)
+			assert.Equal(s, test.orderBy, orderBy)
+			assert.Equal(s, test.err, err)
+		})
+	}
+}
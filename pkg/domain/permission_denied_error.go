@@ -0,0 +1,17 @@
+package domain
+
+// NewPermissionDeniedError returns a new PermissionDeniedError.
+func NewPermissionDeniedError(msg string) PermissionDeniedError {
+	return PermissionDeniedError{msg: msg}
+}
+
+// PermissionDeniedError is returned when the caller does not have permission
+// to perform an operation.
+type PermissionDeniedError struct {
+	msg string
+}
+
+// Error returns the permission denied error string.
+func (e PermissionDeniedError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPermissionDeniedError_ReturnsPermissionDeniedError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewPermissionDeniedError("msg"))
+}
+
+func TestPermissionDeniedError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewPermissionDeniedError(expected)
+	assert.Equal(t, expected, err.Error())
+}
@@ -0,0 +1,113 @@
+package domain
+
+import "fmt"
+
+// Permission is a permission to perform an operation.
+type Permission string
+
+// Permissions for operations on beers.
+const (
+	PermissionGetBeers    Permission = "beers.get"
+	PermissionListBeers   Permission = "beers.list"
+	PermissionCreateBeers Permission = "beers.create"
+	PermissionUpdateBeers Permission = "beers.update"
+	PermissionDeleteBeers Permission = "beers.delete"
+)
+
+// Permissions for operations on API keys.
+const (
+	PermissionCreateAPIKeys Permission = "apikeys.create"
+	PermissionListAPIKeys   Permission = "apikeys.list"
+	PermissionRevokeAPIKeys Permission = "apikeys.revoke"
+)
+
+// Permissions are all the permissions.
+var Permissions = []Permission{
+	PermissionGetBeers,
+	PermissionListBeers,
+	PermissionCreateBeers,
+	PermissionUpdateBeers,
+	PermissionDeleteBeers,
+	PermissionCreateAPIKeys,
+	PermissionListAPIKeys,
+	PermissionRevokeAPIKeys,
+}
+
+// IsKnownPermission returns true if the permission is one of the permissions.
+func IsKnownPermission(permission Permission) bool {
+	for _, p := range Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Roles.
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// DefaultPolicy returns the default policy. Viewers can get and list beers,
+// editors can also create and update beers and admins can also delete beers
+// and manage API keys.
+func DefaultPolicy() *Policy {
+	viewer := []Permission{PermissionGetBeers, PermissionListBeers}
+	editor := append(append([]Permission{}, viewer...), PermissionCreateBeers, PermissionUpdateBeers)
+	admin := append(append([]Permission{}, editor...), PermissionDeleteBeers,
+		PermissionCreateAPIKeys, PermissionListAPIKeys, PermissionRevokeAPIKeys)
+	policy, err := NewPolicy(map[string][]Permission{
+		RoleViewer: viewer,
+		RoleEditor: editor,
+		RoleAdmin:  admin,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// NewPolicy creates a new policy granting permissions to roles.
+func NewPolicy(roles map[string][]Permission) (*Policy, error) {
+	policy := &Policy{roles: make(map[string]map[Permission]bool)}
+	for role, permissions := range roles {
+		if role == "" {
+			return nil, NewValidationError("role is empty")
+		}
+		granted := make(map[Permission]bool)
+		for _, p := range permissions {
+			if !IsKnownPermission(p) {
+				return nil, NewValidationError(fmt.Sprintf("unknown permission '%s' for role '%s'", p, role))
+			}
+			granted[p] = true
+		}
+		policy.roles[role] = granted
+	}
+	return policy, nil
+}
+
+// Policy grants permissions to roles.
+type Policy struct {
+	roles map[string]map[Permission]bool
+}
+
+// Authorize returns a PermissionDeniedError if neither the principal's scopes
+// include the permission nor any of the principal's roles is granted the
+// permission. Roles not in the policy grant no permissions.
+func (p *Policy) Authorize(principal *Principal, permission Permission) error {
+	if principal != nil {
+		for _, scope := range principal.Scopes {
+			if scope == permission {
+				return nil
+			}
+		}
+		for _, role := range principal.Roles {
+			if p.roles[role][permission] {
+				return nil
+			}
+		}
+	}
+	return NewPermissionDeniedError(fmt.Sprintf("permission '%s' denied", permission))
+}
@@ -0,0 +1,114 @@
+package domain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultPolicy_Authorize(t *testing.T) {
+	t.Parallel()
+	policy := domain.DefaultPolicy()
+	tests := []struct {
+		role    string
+		granted []domain.Permission
+	}{
+		{
+			role:    domain.RoleViewer,
+			granted: []domain.Permission{domain.PermissionGetBeers, domain.PermissionListBeers},
+		},
+		{
+			role: domain.RoleEditor,
+			granted: []domain.Permission{domain.PermissionGetBeers, domain.PermissionListBeers,
+				domain.PermissionCreateBeers, domain.PermissionUpdateBeers},
+		},
+		{
+			role:    domain.RoleAdmin,
+			granted: domain.Permissions,
+		},
+		{
+			role: "unknown",
+		},
+	}
+
+	for _, test := range tests {
+		for _, permission := range domain.Permissions {
+			t.Run(fmt.Sprintf("test %s %s", test.role, permission), func(s *testing.T) {
+				err := policy.Authorize(&domain.Principal{Subject: "subject", Roles: []string{test.role}}, permission)
+				if contains(test.granted, permission) {
+					assert.Nil(s, err)
+				} else {
+					assert.Equal(s, domain.NewPermissionDeniedError(fmt.Sprintf("permission '%s' denied", permission)), err)
+				}
+			})
+		}
+	}
+}
+
+func contains(permissions []domain.Permission, permission domain.Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPolicy_Authorize_WhenAnyRoleGranted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	principal := &domain.Principal{Subject: "subject", Roles: []string{"unknown", domain.RoleAdmin}}
+	assert.Nil(t, domain.DefaultPolicy().Authorize(principal, domain.PermissionDeleteBeers))
+}
+
+func TestPolicy_Authorize_WhenScopeGranted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	principal := &domain.Principal{Subject: "subject", Scopes: []domain.Permission{domain.PermissionDeleteBeers}}
+	policy := domain.DefaultPolicy()
+	assert.Nil(t, policy.Authorize(principal, domain.PermissionDeleteBeers))
+	assert.Equal(t, domain.NewPermissionDeniedError("permission 'beers.get' denied"),
+		policy.Authorize(principal, domain.PermissionGetBeers))
+}
+
+func TestIsKnownPermission(t *testing.T) {
+	t.Parallel()
+	for _, permission := range domain.Permissions {
+		assert.True(t, domain.IsKnownPermission(permission))
+	}
+	assert.False(t, domain.IsKnownPermission("beers.drink"))
+}
+
+func TestPolicy_Authorize_WhenNoPrincipal_ReturnsPermissionDeniedError(t *testing.T) {
+	t.Parallel()
+	err := domain.DefaultPolicy().Authorize(nil, domain.PermissionGetBeers)
+	assert.Equal(t, domain.NewPermissionDeniedError("permission 'beers.get' denied"), err)
+}
+
+func TestNewPolicy_WhenInvalid_ReturnsValidationError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		roles map[string][]domain.Permission
+		err   error
+	}{
+		{
+			name:  "empty role",
+			roles: map[string][]domain.Permission{"": {domain.PermissionGetBeers}},
+			err:   domain.NewValidationError("role is empty"),
+		},
+		{
+			name:  "unknown permission",
+			roles: map[string][]domain.Permission{"role": {"beers.drink"}},
+			err:   domain.NewValidationError("unknown permission 'beers.drink' for role 'role'"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := domain.NewPolicy(test.roles)
+			assert.Equal(s, test.err, err)
+		})
+	}
+}
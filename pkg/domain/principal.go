@@ -0,0 +1,27 @@
+package domain
+
+import "context"
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// Subject identifies the caller.
+	Subject string
+	// Roles are the roles granted to the caller.
+	Roles []string
+	// Scopes are the permissions granted to the caller directly, such as
+	// the scopes of an API key.
+	Scopes []Permission
+}
+
+type principalKey struct{}
+
+// NewContextWithPrincipal returns a new context carrying the principal.
+func NewContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal carried by the context, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
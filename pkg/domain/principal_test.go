@@ -0,0 +1,26 @@
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipalFromContext_ReturnsPrincipal(t *testing.T) {
+	t.Parallel()
+	expected := &domain.Principal{Subject: "subject", Roles: []string{"viewer"}}
+	ctx := domain.NewContextWithPrincipal(context.Background(), expected)
+	actual, ok := domain.PrincipalFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, expected, actual)
+}
+
+func TestPrincipalFromContext_WhenNoPrincipal_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	actual, ok := domain.PrincipalFromContext(context.Background())
+	assert.False(t, ok)
+	assert.Nil(t, actual)
+}
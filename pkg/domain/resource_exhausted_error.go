@@ -0,0 +1,17 @@
+package domain
+
+// NewResourceExhaustedError returns a new ResourceExhaustedError.
+func NewResourceExhaustedError(msg string) ResourceExhaustedError {
+	return ResourceExhaustedError{msg: msg}
+}
+
+// ResourceExhaustedError is returned when the caller has exhausted a resource,
+// such as their rate limit.
+type ResourceExhaustedError struct {
+	msg string
+}
+
+// Error returns the resource exhausted error string.
+func (e ResourceExhaustedError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResourceExhaustedError_ReturnsResourceExhaustedError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewResourceExhaustedError("msg"))
+}
+
+func TestResourceExhaustedError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewResourceExhaustedError(expected)
+	assert.Equal(t, expected, err.Error())
+}
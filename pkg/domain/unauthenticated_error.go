@@ -0,0 +1,16 @@
+package domain
+
+// NewUnauthenticatedError returns a new UnauthenticatedError.
+func NewUnauthenticatedError(msg string) UnauthenticatedError {
+	return UnauthenticatedError{msg: msg}
+}
+
+// UnauthenticatedError is returned when the caller cannot be authenticated.
+type UnauthenticatedError struct {
+	msg string
+}
+
+// Error returns the unauthenticated error string.
+func (e UnauthenticatedError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,21 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUnauthenticatedError_ReturnsUnauthenticatedError(t *testing.T) {
+	t.Parallel()
+	assert.NotNil(t, domain.NewUnauthenticatedError("msg"))
+}
+
+func TestUnauthenticatedError_Error_ReturnsErrorString(t *testing.T) {
+	t.Parallel()
+	expected := "msg"
+	err := domain.NewUnauthenticatedError(expected)
+	assert.Equal(t, expected, err.Error())
+}
@@ -0,0 +1,143 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/lib/pq"
+)
+
+// NewPostgresAPIKeyRepository creates a new postgres API key repository using
+// the connection pool to the database.
+func NewPostgresAPIKeyRepository(db *sql.DB, generateID GenerateID) *PostgresAPIKeyRepository {
+	return &PostgresAPIKeyRepository{
+		db:         newTracedDB(db),
+		generateID: generateID,
+	}
+}
+
+// PostgresAPIKeyRepository is a postgres API key repository.
+type PostgresAPIKeyRepository struct {
+	db         *tracedDB
+	generateID func() string
+}
+
+// apiKeyColumns are the columns of the API_KEYS table in the order they are
+// scanned by scanAPIKey.
+const apiKeyColumns = "id, name, hash, scopes, create_time, expire_time, revoke_time"
+
+// CreateAPIKey creates an API key in the postgres database.
+func (repo *PostgresAPIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	id := repo.generateID()
+	sqlStatement := `
+	INSERT INTO API_KEYS (id, name, hash, scopes, create_time, expire_time)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING ` + apiKeyColumns
+	row := repo.db.QueryRowContext(ctx, sqlStatement, id, key.Name, key.Hash,
+		pq.Array(toStrings(key.Scopes)), key.CreateTime, key.ExpireTime)
+	created, err := scanAPIKey(row)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, domain.NewAlreadyExistsError(fmt.Sprintf("api key '%s' already exists", id))
+		}
+		return nil, queryError(ctx, err)
+	}
+	return created, nil
+}
+
+// GetAPIKeyByHash gets the API key with the hash from the postgres database.
+func (repo *PostgresAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error) {
+	row := repo.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM API_KEYS WHERE hash = $1;", hash)
+	key, err := scanAPIKey(row)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, domain.NewNotFoundError("api key not found")
+	case nil:
+		return key, nil
+	default:
+		return nil, queryError(ctx, err)
+	}
+}
+
+// ListAPIKeys lists all API keys in the postgres database, ordered by ID.
+func (repo *PostgresAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	rows, err := repo.db.QueryContext(ctx, "SELECT "+apiKeyColumns+" FROM API_KEYS ORDER BY id;")
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	defer rows.Close()
+
+	keys := []*domain.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	// Check for any errors encountered.
+	err = rows.Err()
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key in the postgres database. Revoking a
+// revoked API key keeps the original revoke time.
+func (repo *PostgresAPIKeyRepository) RevokeAPIKey(ctx context.Context, params *domain.RevokeAPIKeyParams, revokeTime time.Time) error {
+	sqlStatement := `
+	UPDATE API_KEYS
+	SET revoke_time = COALESCE(revoke_time, $2)
+	WHERE id = $1;`
+	result, err := repo.db.ExecContext(ctx, sqlStatement, params.ID, revokeTime)
+	if err != nil {
+		return queryError(ctx, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return apiKeyNotFound(params.ID)
+	}
+	return nil
+}
+
+// scanner scans a row, either *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAPIKey scans an API key from the apiKeyColumns of a row.
+func scanAPIKey(row scanner) (*domain.APIKey, error) {
+	var (
+		key        domain.APIKey
+		scopes     []string
+		revokeTime pq.NullTime
+	)
+	err := row.Scan(&key.ID, &key.Name, &key.Hash, pq.Array(&scopes), &key.CreateTime, &key.ExpireTime, &revokeTime)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, domain.Permission(scope))
+	}
+	if revokeTime.Valid {
+		key.RevokeTime = &revokeTime.Time
+	}
+	return &key, nil
+}
+
+func toStrings(permissions []domain.Permission) []string {
+	out := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		out = append(out, string(p))
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewPostgresAuditRepository creates a new postgres audit repository using
+// the connection pool to the database.
+func NewPostgresAuditRepository(db *sql.DB) *PostgresAuditRepository {
+	return &PostgresAuditRepository{
+		db: newTracedDB(db),
+	}
+}
+
+// PostgresAuditRepository is a postgres audit repository.
+type PostgresAuditRepository struct {
+	db *tracedDB
+}
+
+// auditEntryColumns are the columns of the BEER_AUDIT table in the order they
+// are scanned by scanAuditEntry.
+const auditEntryColumns = "id, beer_id, action, actor, request_id, changes, create_time"
+
+// postgresFieldChange is the JSON representation of a field change in the
+// changes column of the BEER_AUDIT table.
+type postgresFieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// insertAuditEntrySQL is the statement inserting an audit entry.
+const insertAuditEntrySQL = `
+	INSERT INTO BEER_AUDIT (id, beer_id, action, actor, request_id, changes, create_time)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+// marshalChanges returns the JSON of the changes column of an audit entry.
+func marshalChanges(entry *domain.AuditEntry) (string, error) {
+	changes := make([]postgresFieldChange, 0, len(entry.Changes))
+	for _, change := range entry.Changes {
+		changes = append(changes, postgresFieldChange(change))
+	}
+	buf, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// insertAuditEntry inserts the audit entry of a mutation of a beer, with the
+// given ID, in the transaction of the mutation, so that the mutation is rolled
+// back if its audit entry cannot be inserted.
+func insertAuditEntry(ctx context.Context, tx *tracedTx, id string, entry *domain.AuditEntry) error {
+	changes, err := marshalChanges(entry)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, insertAuditEntrySQL+";", id, entry.BeerID, string(entry.Action),
+		entry.Actor, entry.RequestID, changes, entry.CreateTime)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.NewAlreadyExistsError(fmt.Sprintf("audit entry '%s' already exists", id))
+		}
+		return queryError(ctx, err)
+	}
+	return nil
+}
+
+// ListAuditEntries lists the audit entries of a beer in the postgres database
+// in the order they were created.
+func (repo *PostgresAuditRepository) ListAuditEntries(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error) {
+	rows, err := repo.db.QueryContext(ctx,
+		"SELECT "+auditEntryColumns+" FROM BEER_AUDIT WHERE beer_id = $1 ORDER BY seq;", params.ID)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	defer rows.Close()
+
+	entries := []*domain.AuditEntry{}
+	for rows.Next() {
+		entry, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	// Check for any errors encountered.
+	err = rows.Err()
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	return entries, nil
+}
+
+// scanAuditEntry scans an audit entry from the auditEntryColumns of a row.
+func scanAuditEntry(row scanner) (*domain.AuditEntry, error) {
+	var (
+		entry   domain.AuditEntry
+		action  string
+		changes []byte
+	)
+	err := row.Scan(&entry.ID, &entry.BeerID, &action, &entry.Actor, &entry.RequestID, &changes, &entry.CreateTime)
+	if err != nil {
+		return nil, err
+	}
+	entry.Action = domain.AuditAction(action)
+
+	var fieldChanges []postgresFieldChange
+	if err := json.Unmarshal(changes, &fieldChanges); err != nil {
+		return nil, err
+	}
+	for _, change := range fieldChanges {
+		entry.Changes = append(entry.Changes, domain.FieldChange(change))
+	}
+	return &entry, nil
+}
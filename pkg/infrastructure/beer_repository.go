@@ -5,14 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 
-	_ "github.com/lib/pq" // imported for side effect.
+	"github.com/lib/pq"
 )
 
-const numberRowsLimit = 100
-
 // PostgresSettings describes all the settings required for setting up
 // a connection to a postgres database.
 type PostgresSettings struct {
@@ -21,145 +21,465 @@ type PostgresSettings struct {
 	User     string
 	Password string
 	DBName   string
+	// SSLMode is the postgres sslmode, disable if empty.
+	SSLMode string
+	// SSLRootCert is the file holding the CAs the server certificate is
+	// verified with when SSLMode is verify-ca or verify-full.
+	SSLRootCert string
+	// StatementTimeout is the time after which the server cancels a
+	// statement, no timeout if zero.
+	StatementTimeout time.Duration
+	// MaxOpenConns is the maximum number of open connections, unlimited if
+	// zero.
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle connections, the
+	// database/sql default if zero.
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum time a connection is reused, unlimited
+	// if zero.
+	ConnMaxLifetime time.Duration
 }
 
 // String returns the string representation for a postgres database.
 func (s *PostgresSettings) String() string {
-	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
-		s.Host, s.Port, s.User, s.Password, s.DBName)
+	sslMode := s.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	str := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		s.Host, s.Port, s.User, s.Password, s.DBName, sslMode)
+	if s.SSLRootCert != "" {
+		str += fmt.Sprintf(" sslrootcert=%s", s.SSLRootCert)
+	}
+	if s.StatementTimeout > 0 {
+		str += fmt.Sprintf(" statement_timeout=%d", s.StatementTimeout.Milliseconds())
+	}
+	return str
 }
 
+// beerColumns are the columns of the BEERS table in the order they are scanned
+// into a postgresBeer.
+const beerColumns = "id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at"
+
 // postgresBeer is the postgres representation of a beer.
 type postgresBeer struct {
-	ID      string
-	Name    string
-	Type    int
-	Brewer  string
-	Country string
+	ID          string
+	Name        string
+	Type        int
+	Brewer      string
+	Country     string
+	ABV         float64
+	IBU         int
+	SRM         float64
+	VolumeML    int
+	Description string
+	Version     int64
+	DeleteTime  pq.NullTime
+}
+
+// scanFields returns the fields of the beer to scan the beerColumns into.
+func (b *postgresBeer) scanFields() []interface{} {
+	return []interface{}{
+		&b.ID, &b.Name, &b.Type, &b.Brewer, &b.Country,
+		&b.ABV, &b.IBU, &b.SRM, &b.VolumeML, &b.Description,
+		&b.Version, &b.DeleteTime,
+	}
 }
 
 // GenerateID generates a unique identifier.
 type GenerateID func() string
 
-// NewPostgresBeerRepository creates a new postgres beer repository.
-func NewPostgresBeerRepository(settings *PostgresSettings,
-	generateID GenerateID) (*PostgresBeerRepository, error) {
+// OpenPostgres opens a connection pool to the postgres database and checks
+// the database is reachable. The pool is shared by the postgres repositories,
+// so that the pool settings limit the connections of all of them, and must be
+// closed once finished with.
+func OpenPostgres(settings *PostgresSettings) (*sql.DB, error) {
 	db, err := sql.Open("postgres", settings.String())
 	if err != nil {
 		return nil, err
 	}
+	db.SetMaxOpenConns(settings.MaxOpenConns)
+	if settings.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(settings.MaxIdleConns)
+	}
+	db.SetConnMaxLifetime(settings.ConnMaxLifetime)
 
 	err = db.Ping()
 	if err != nil {
+		db.Close()
 		return nil, err
 	}
+	return db, nil
+}
 
+// NewPostgresBeerRepository creates a new postgres beer repository using the
+// connection pool to the database.
+func NewPostgresBeerRepository(db *sql.DB, generateID GenerateID) *PostgresBeerRepository {
 	return &PostgresBeerRepository{
-		db:         db,
+		db:         newTracedDB(db),
 		generateID: generateID,
-	}, nil
+	}
 }
 
 // PostgresBeerRepository is a postgres beer repository.
 type PostgresBeerRepository struct {
-	db         *sql.DB
+	db         *tracedDB
 	generateID func() string
 }
 
-// Close closes the postgres database.
-func (repo *PostgresBeerRepository) Close() error {
-	if repo.db != nil {
-		return repo.db.Close()
-	}
-	return nil
+// Ping checks the postgres database is reachable.
+func (repo *PostgresBeerRepository) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
 }
 
-// CreateBeer creates a beer in the postgres database.
-func (repo *PostgresBeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams) (*domain.Beer, error) {
+// CreateBeer creates a beer in the postgres database and inserts its audit
+// entry in the same transaction.
+func (repo *PostgresBeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
 	id := repo.generateID()
 	sqlStatement := `
-	INSERT INTO BEERS (id, name, type, brewer, country)
-	VALUES ($1, $2, $3, $4, $5)
-	RETURNING id`
-	err := repo.db.QueryRow(sqlStatement, id, params.Name, params.Type, params.Brewer, params.Country).Scan(&id)
+	INSERT INTO BEERS (id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1)
+	RETURNING ` + beerColumns + `;`
+	var created *domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		var beer postgresBeer
+		err := tx.QueryRowContext(ctx, sqlStatement, id, params.Name, params.Type, params.Brewer, params.Country,
+			params.ABV, params.IBU, params.SRM, params.VolumeML, params.Description).Scan(beer.scanFields()...)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.NewAlreadyExistsError(fmt.Sprintf("beer '%s' already exists", id))
+			}
+			return queryError(ctx, err)
+		}
+		created = toDomainBeer(&beer)
+		return repo.record(ctx, tx, audit.Entry(domain.AuditActionCreate, nil, created))
+	})
 	if err != nil {
 		return nil, err
 	}
-	return repo.GetBeer(ctx, &domain.GetBeerParams{ID: id})
+	return created, nil
 }
 
-// GetBeer gets a beer from the postgres database.
+// GetBeer gets a beer from the postgres database. Deleted beers are only got
+// if the params show deleted beers.
 func (repo *PostgresBeerRepository) GetBeer(ctx context.Context, params *domain.GetBeerParams) (*domain.Beer, error) {
+	query := "SELECT " + beerColumns + " FROM BEERS WHERE id=$1"
+	if !params.ShowDeleted {
+		query += " AND deleted_at IS NULL"
+	}
 	var beer postgresBeer
-	row := repo.db.QueryRow("SELECT * FROM BEERS WHERE id=$1;", params.ID)
-	err := row.Scan(&beer.ID, &beer.Name, &beer.Type, &beer.Brewer, &beer.Country)
+	row := repo.db.QueryRowContext(ctx, query+";", params.ID)
+	err := row.Scan(beer.scanFields()...)
 	switch err {
 	case sql.ErrNoRows:
-		return nil, errors.New("not found")
+		return nil, beerNotFound(params.ID)
 	case nil:
 		return toDomainBeer(&beer), nil
 	default:
+		if err := contextError(ctx, err); err != nil {
+			return nil, err
+		}
 		return nil, errors.New("something unexpected happened")
 	}
 }
 
-// UpdateBeer updates a beer in the postgres database.
-func (repo *PostgresBeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error) {
-	if params.Name != nil {
-		sqlStatement := `UPDATE BEERS
-						 SET name = $2
-						 WHERE id = $1;`
-		_, err := repo.db.Exec(sqlStatement, params.ID, params.Name)
+// UpdateBeer updates a beer in the postgres database and inserts its audit
+// entry in the same transaction. The beer is locked before it is updated, so
+// that the audit entry records the changes from the locked beer to the updated
+// beer. The fields in the params are set and the version of the beer is
+// incremented only if the version matches the version in the params when
+// specified, so that of concurrent updates of the same version only one
+// succeeds.
+func (repo *PostgresBeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	var updated *domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		before, err := lockBeer(ctx, tx, params.ID, false)
 		if err != nil {
-			return nil, err
+			return err
 		}
-	}
-	if params.Brewer != nil {
-		sqlStatement := `UPDATE BEERS
-						 SET brewer = $2
-						 WHERE id = $1;`
-		_, err := repo.db.Exec(sqlStatement, params.ID, params.Brewer)
+		query, args := updateBeerQuery(params)
+		updated, err = scanMutatedBeer(ctx, tx.QueryRowContext(ctx, query, args...), params.ID)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		return repo.record(ctx, tx, audit.Entry(domain.AuditActionUpdate, before, updated))
+	})
+	if err != nil {
+		return nil, err
 	}
-	if params.Country != nil {
-		sqlStatement := `UPDATE BEERS
-						 SET country = $2
-						 WHERE id = $1;`
-		_, err := repo.db.Exec(sqlStatement, params.ID, params.Country)
+	return updated, nil
+}
+
+// DeleteBeer marks a beer in the postgres database as deleted at the time of
+// the audit record, increments its version and inserts its audit entry in the
+// same transaction. The beer is removed when it is purged.
+func (repo *PostgresBeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	sqlStatement := `
+	UPDATE BEERS
+	SET deleted_at = $3, version = version + 1
+	WHERE id = $1 AND deleted_at IS NULL AND ($2::BIGINT IS NULL OR version = $2)
+	RETURNING ` + beerColumns + `;`
+	var deleted *domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		before, err := lockBeer(ctx, tx, params.ID, false)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		row := tx.QueryRowContext(ctx, sqlStatement, params.ID, params.Version, audit.Time)
+		deleted, err = scanMutatedBeer(ctx, row, params.ID)
+		if err != nil {
+			return err
+		}
+		return repo.record(ctx, tx, audit.Entry(domain.AuditActionDelete, before, deleted))
+	})
+	if err != nil {
+		return nil, err
 	}
-	return repo.GetBeer(ctx, &domain.GetBeerParams{ID: params.ID})
+	return deleted, nil
 }
 
-// DeleteBeer deletes a beer from the postgres database.
-func (repo *PostgresBeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams) error {
+// UndeleteBeer undeletes a deleted beer in the postgres database, increments
+// its version and inserts its audit entry in the same transaction.
+func (repo *PostgresBeerRepository) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
 	sqlStatement := `
-	DELETE FROM BEERS
-	WHERE id = $1;`
-	_, err := repo.db.Exec(sqlStatement, params.ID)
+	UPDATE BEERS
+	SET deleted_at = NULL, version = version + 1
+	WHERE id = $1 AND deleted_at IS NOT NULL AND ($2::BIGINT IS NULL OR version = $2)
+	RETURNING ` + beerColumns + `;`
+	var undeleted *domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		before, err := lockBeer(ctx, tx, params.ID, true)
+		if err != nil {
+			return err
+		}
+		if before.DeleteTime == nil {
+			return beerNotDeleted(params.ID)
+		}
+		row := tx.QueryRowContext(ctx, sqlStatement, params.ID, params.Version)
+		undeleted, err = scanMutatedBeer(ctx, row, params.ID)
+		if err != nil {
+			return err
+		}
+		return repo.record(ctx, tx, audit.Entry(domain.AuditActionUndelete, before, undeleted))
+	})
 	if err != nil {
+		return nil, err
+	}
+	return undeleted, nil
+}
+
+// inTx calls f with a new transaction, which is committed if f returns nil
+// and rolled back otherwise.
+func (repo *PostgresBeerRepository) inTx(ctx context.Context, f func(tx *tracedTx) error) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return queryError(ctx, err)
+	}
+	defer func() {
+		// Rolling back a committed transaction does nothing.
+		_ = tx.Rollback()
+	}()
+
+	if err := f(tx); err != nil {
 		return err
 	}
+	if err := tx.Commit(); err != nil {
+		return queryError(ctx, err)
+	}
 	return nil
 }
 
-// ListBeers lists all beers from the postgres database.
-func (repo *PostgresBeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error) {
-	offset := numberRowsLimit * (params.Page - 1)
-	rows, err := repo.db.Query("SELECT * FROM BEERS OFFSET $1 LIMIT $2", offset, numberRowsLimit)
+// record inserts the audit entry of a mutation of a beer in the transaction
+// of the mutation.
+func (repo *PostgresBeerRepository) record(ctx context.Context, tx *tracedTx, entry *domain.AuditEntry) error {
+	return insertAuditEntry(ctx, tx, repo.generateID(), entry)
+}
+
+// lockBeer gets a beer in the transaction and locks it until the transaction
+// ends, so that it cannot be mutated by other transactions. Deleted beers are
+// only got if showDeleted is true.
+func lockBeer(ctx context.Context, tx *tracedTx, id string, showDeleted bool) (*domain.Beer, error) {
+	query := "SELECT " + beerColumns + " FROM BEERS WHERE id = $1"
+	if !showDeleted {
+		query += " AND deleted_at IS NULL"
+	}
+	var beer postgresBeer
+	err := tx.QueryRowContext(ctx, query+" FOR UPDATE;", id).Scan(beer.scanFields()...)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, beerNotFound(id)
+	case nil:
+		return toDomainBeer(&beer), nil
+	default:
+		return nil, queryError(ctx, err)
+	}
+}
+
+// scanMutatedBeer scans the beer returned by a statement mutating a locked
+// beer. The beer cannot have changed since it was locked, so the statement
+// only returns no beer if the beer does not have the version in the params.
+func scanMutatedBeer(ctx context.Context, row scanner, id string) (*domain.Beer, error) {
+	var beer postgresBeer
+	err := row.Scan(beer.scanFields()...)
+	switch err {
+	case sql.ErrNoRows:
+		return nil, beerModified(id)
+	case nil:
+		return toDomainBeer(&beer), nil
+	default:
+		return nil, queryError(ctx, err)
+	}
+}
+
+// PurgeBeers permanently removes the beers deleted before the given time from
+// the postgres database.
+func (repo *PostgresBeerRepository) PurgeBeers(ctx context.Context, deletedBefore time.Time) (int, error) {
+	result, err := repo.db.ExecContext(ctx, "DELETE FROM BEERS WHERE deleted_at < $1;", deletedBefore)
+	if err != nil {
+		return 0, queryError(ctx, err)
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
+
+// BatchGetBeers gets a batch of beers from the postgres database in a single
+// query.
+func (repo *PostgresBeerRepository) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error) {
+	query := "SELECT " + beerColumns + " FROM BEERS WHERE id = ANY($1) AND deleted_at IS NULL;"
+	found, err := queryBeers(ctx, repo.db, query, pq.Array(params.IDs))
+	if err != nil {
+		return nil, err
+	}
+	return orderBeers(found, params.IDs)
+}
+
+// BatchCreateBeers creates a batch of beers in the postgres database in a
+// single statement, run in a transaction with the inserts of their audit
+// entries.
+func (repo *PostgresBeerRepository) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	ids := make([]string, 0, len(params.Beers))
+	for range params.Beers {
+		ids = append(ids, repo.generateID())
+	}
+	query, args := batchCreateBeersQuery(params, ids)
+	var beers []*domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		created, err := queryBeers(ctx, tx, query, args...)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.NewAlreadyExistsError("a beer in the batch already exists")
+			}
+			return err
+		}
+		beers, err = orderBeers(created, ids)
+		if err != nil {
+			return err
+		}
+		for _, beer := range beers {
+			if err := repo.record(ctx, tx, audit.Entry(domain.AuditActionCreate, nil, beer)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	return beers, nil
+}
+
+// BatchDeleteBeers marks a batch of beers in the postgres database as deleted
+// and increments their versions in a single statement. The beers are locked
+// and checked before the statement, and the statement is run in a transaction
+// with the inserts of their audit entries, so either every beer is deleted or
+// none are.
+func (repo *PostgresBeerRepository) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	ids := make([]string, 0, len(params.Beers))
+	for _, beer := range params.Beers {
+		ids = append(ids, beer.ID)
+	}
+
+	// The beers are locked in ID order so that concurrent batches cannot
+	// deadlock.
+	lockQuery := "SELECT " + beerColumns + " FROM BEERS WHERE id = ANY($1) AND deleted_at IS NULL ORDER BY id FOR UPDATE;"
+	sqlStatement := `
+	UPDATE BEERS SET deleted_at = $2, version = version + 1
+	WHERE id = ANY($1)
+	RETURNING ` + beerColumns + `;`
+	var deleted []*domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		locked, err := queryBeers(ctx, tx, lockQuery, pq.Array(ids))
+		if err != nil {
+			return err
+		}
+		before, err := orderBeers(locked, ids)
+		if err != nil {
+			return err
+		}
+		for i, beer := range params.Beers {
+			if beer.Version != nil && *beer.Version != before[i].Version {
+				return beerModified(beer.ID)
+			}
+		}
+
+		updated, err := queryBeers(ctx, tx, sqlStatement, pq.Array(ids), audit.Time)
+		if err != nil {
+			return err
+		}
+		deleted, err = orderBeers(updated, ids)
+		if err != nil {
+			return err
+		}
+		for i, beer := range deleted {
+			if err := repo.record(ctx, tx, audit.Entry(domain.AuditActionDelete, before[i], beer)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// orderBeers returns the beers in the order of the IDs, failing with a not
+// found error for the first ID without a beer.
+func orderBeers(beers []*domain.Beer, ids []string) ([]*domain.Beer, error) {
+	byID := make(map[string]*domain.Beer, len(beers))
+	for _, beer := range beers {
+		byID[beer.ID] = beer
+	}
+	ordered := make([]*domain.Beer, 0, len(ids))
+	for _, id := range ids {
+		beer, ok := byID[id]
+		if !ok {
+			return nil, beerNotFound(id)
+		}
+		ordered = append(ordered, beer)
+	}
+	return ordered, nil
+}
+
+// ListBeers lists a page of beers from the postgres database.
+func (repo *PostgresBeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error) {
+	query, args, err := listBeersQuery(params)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
 	defer rows.Close()
 
 	var beers []*domain.Beer
 	for rows.Next() {
 		var beer postgresBeer
-		err := rows.Scan(&beer.ID, &beer.Name, &beer.Type, &beer.Brewer, &beer.Country)
+		err := rows.Scan(beer.scanFields()...)
 
 		if err != nil {
 			return nil, err
@@ -170,18 +490,491 @@ func (repo *PostgresBeerRepository) ListBeers(ctx context.Context, params *domai
 	// Check for any errors encountered.
 	err = rows.Err()
 	if err != nil {
-		return nil, err
+		return nil, queryError(ctx, err)
+	}
+
+	result := &domain.ListBeersResult{Beers: beers}
+
+	// One more beer than the page size is queried to find out if there is
+	// a next page.
+	if len(beers) > params.PageSize {
+		result.Beers = beers[:params.PageSize]
+		result.Next = domain.NewCursor(result.Beers[params.PageSize-1])
+	}
+
+	query, args = countBeersQuery(params)
+	err = repo.db.QueryRowContext(ctx, query, args...).Scan(&result.TotalSize)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+
+	return result, nil
+}
+
+// SearchBeers searches the beers in the postgres database. Beers match if
+// their text search vector, of their name, brewer and country, has words
+// starting with every search term, or if the search query is similar to the
+// words of their name, brewer and country, so that misspelled searches still
+// match. Beers are scored by the sum of their text search rank and trigram
+// word similarity.
+func (repo *PostgresBeerRepository) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error) {
+	query, args := searchBeersQuery(params)
+	rows, err := repo.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	defer rows.Close()
+
+	var results []*domain.SearchResult
+	for rows.Next() {
+		var (
+			beer  postgresBeer
+			score float64
+		)
+		err := rows.Scan(append(beer.scanFields(), &score)...)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &domain.SearchResult{Beer: toDomainBeer(&beer), Score: score})
 	}
 
+	// Check for any errors encountered.
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, err)
+	}
+
+	result := &domain.SearchBeersResult{Results: results}
+
+	// One more beer than the page size is queried to find out if there is
+	// a next page.
+	if len(results) > params.PageSize {
+		result.Results = results[:params.PageSize]
+		last := result.Results[params.PageSize-1]
+		result.Next = &domain.Cursor{ID: last.Beer.ID, Score: last.Score}
+	}
+	return result, nil
+}
+
+// exportFetchSize is the number of beers fetched from the cursor of an export
+// at a time.
+const exportFetchSize = 1000
+
+// ExportBeers calls the handler with every beer in the postgres database in ID
+// order. The beers are fetched from a cursor in a read only, repeatable read
+// transaction, so the export is a consistent snapshot of the beers without the
+// beers being held in memory.
+func (repo *PostgresBeerRepository) ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error {
+	tx, err := repo.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return queryError(ctx, err)
+	}
+	defer func() {
+		// The transaction only reads, so it is always rolled back.
+		_ = tx.Rollback()
+	}()
+
+	query := "DECLARE export_beers NO SCROLL CURSOR FOR SELECT " + beerColumns + " FROM BEERS"
+	if !params.ShowDeleted {
+		query += " WHERE deleted_at IS NULL"
+	}
+	if _, err := tx.ExecContext(ctx, query+" ORDER BY id;"); err != nil {
+		return queryError(ctx, err)
+	}
+
+	for {
+		beers, err := queryBeers(ctx, tx, fmt.Sprintf("FETCH FORWARD %d FROM export_beers;", exportFetchSize))
+		if err != nil {
+			return err
+		}
+		if len(beers) == 0 {
+			return nil
+		}
+		for _, beer := range beers {
+			if err := handler(beer); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// queryer executes queries which return rows, either in a transaction or not.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// queryBeers returns the beers queried by the query.
+func queryBeers(ctx context.Context, q queryer, query string, args ...interface{}) ([]*domain.Beer, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	defer rows.Close()
+
+	var beers []*domain.Beer
+	for rows.Next() {
+		var beer postgresBeer
+		err := rows.Scan(beer.scanFields()...)
+		if err != nil {
+			return nil, err
+		}
+		beers = append(beers, toDomainBeer(&beer))
+	}
+
+	// Check for any errors encountered.
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, err)
+	}
 	return beers, nil
 }
 
+// ImportBeers creates the beers which do not already exist in the postgres
+// database, and records their audit entries, in a single transaction, so
+// either every beer is imported or none are.
+func (repo *PostgresBeerRepository) ImportBeers(ctx context.Context, beers []*domain.Beer, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	sqlStatement := `
+	INSERT INTO BEERS (id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1, $11)
+	ON CONFLICT (id) DO NOTHING
+	RETURNING ` + beerColumns + `;`
+	var created []*domain.Beer
+	err := repo.inTx(ctx, func(tx *tracedTx) error {
+		for _, in := range beers {
+			id := in.ID
+			if id == "" {
+				id = repo.generateID()
+			}
+			var beer postgresBeer
+			row := tx.QueryRowContext(ctx, sqlStatement, id, in.Name, in.Type, in.Brewer, in.Country,
+				in.ABV, in.IBU, in.SRM, in.VolumeML, in.Description, in.DeleteTime)
+			err := row.Scan(beer.scanFields()...)
+			switch err {
+			case sql.ErrNoRows:
+				// The beer already exists.
+				continue
+			case nil:
+			default:
+				return queryError(ctx, err)
+			}
+			imported := toDomainBeer(&beer)
+			if err := repo.record(ctx, tx, audit.Entry(domain.AuditActionCreate, nil, imported)); err != nil {
+				return err
+			}
+			created = append(created, imported)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+const (
+	// uniqueViolation is the postgres error code for unique constraint
+	// violations.
+	uniqueViolation = "23505"
+	// queryCanceled is the postgres error code for statements canceled by the
+	// statement timeout or by a cancel request, which the driver sends when
+	// the context of a statement is done.
+	queryCanceled = "57014"
+)
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+// contextError returns the error of a statement which failed because its
+// context is done, context.Canceled or context.DeadlineExceeded, or because it
+// was canceled by the statement timeout, context.DeadlineExceeded. Otherwise it
+// returns nil.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == queryCanceled {
+		return fmt.Errorf("%s: %w", pqErr.Message, context.DeadlineExceeded)
+	}
+	return nil
+}
+
+// queryError returns the context error of a failed statement if it failed
+// because it was canceled, or the error otherwise.
+func queryError(ctx context.Context, err error) error {
+	if ctxErr := contextError(ctx, err); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+func beerNotFound(id string) error {
+	return domain.NewNotFoundError(fmt.Sprintf("beer '%s' not found", id))
+}
+
+func beerModified(id string) error {
+	return domain.NewConflictError(fmt.Sprintf("beer '%s' has been modified", id))
+}
+
+func beerNotDeleted(id string) error {
+	return domain.NewFailedPreconditionError(fmt.Sprintf("beer '%s' is not deleted", id))
+}
+
+// orderByColumns maps the domain order by fields to BEERS table columns.
+var orderByColumns = map[string]string{
+	domain.OrderByID:      "id",
+	domain.OrderByName:    "name",
+	domain.OrderByType:    "type",
+	domain.OrderByBrewer:  "brewer",
+	domain.OrderByCountry: "country",
+}
+
+// cursorValue returns the value of the field to order by for a cursor.
+func cursorValue(cursor *domain.Cursor, field string) interface{} {
+	switch field {
+	case domain.OrderByName:
+		return cursor.Name
+	case domain.OrderByType:
+		return int(cursor.Type)
+	case domain.OrderByBrewer:
+		return cursor.Brewer
+	case domain.OrderByCountry:
+		return cursor.Country
+	default:
+		return cursor.ID
+	}
+}
+
+// queryBuilder builds parameterised SQL queries. Only whitelisted column names
+// are ever written into a query, all values are passed as arguments.
+type queryBuilder struct {
+	conditions []string
+	args       []interface{}
+}
+
+// arg adds an argument and returns its placeholder.
+func (b *queryBuilder) arg(arg interface{}) string {
+	b.args = append(b.args, arg)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// where adds the filters for listing beers as conditions. Deleted beers are
+// filtered out unless the params show deleted beers.
+func (b *queryBuilder) where(params *domain.ListBeersParams) {
+	if !params.ShowDeleted {
+		b.conditions = append(b.conditions, "deleted_at IS NULL")
+	}
+	if params.Type != nil {
+		b.conditions = append(b.conditions, "type = "+b.arg(int(*params.Type)))
+	}
+	if params.Brewer != "" {
+		b.conditions = append(b.conditions, "brewer = "+b.arg(params.Brewer))
+	}
+	if params.Country != "" {
+		b.conditions = append(b.conditions, "country = "+b.arg(params.Country))
+	}
+	if params.NamePrefix != "" {
+		b.conditions = append(b.conditions, "name LIKE "+b.arg(escapeLike(params.NamePrefix)+"%"))
+	}
+}
+
+// after adds the condition for the beers which follow the cursor in the
+// given order. For an order (a, b) the condition is
+// a > $1 OR (a = $1 AND b > $2), with < used for descending fields.
+func (b *queryBuilder) after(cursor *domain.Cursor, orderBy []domain.OrderBy) {
+	var (
+		alternatives []string
+		equal        []string
+	)
+	for _, o := range orderBy {
+		column := orderByColumns[o.Field]
+		placeholder := b.arg(cursorValue(cursor, o.Field))
+		op := " > "
+		if o.Descending {
+			op = " < "
+		}
+		alternative := append(append([]string{}, equal...), column+op+placeholder)
+		alternatives = append(alternatives, "("+strings.Join(alternative, " AND ")+")")
+		equal = append(equal, column+" = "+placeholder)
+	}
+	b.conditions = append(b.conditions, "("+strings.Join(alternatives, " OR ")+")")
+}
+
+func (b *queryBuilder) String() string {
+	if len(b.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conditions, " AND ")
+}
+
+// listOrderBy returns the order to list beers in. The order always ends with
+// the beer id so that the order is total and keyset pagination is stable.
+func listOrderBy(params *domain.ListBeersParams) ([]domain.OrderBy, error) {
+	orderBy, err := domain.ParseOrderBy(params.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orderBy {
+		if o.Field == domain.OrderByID {
+			return orderBy, nil
+		}
+	}
+	return append(orderBy, domain.OrderBy{Field: domain.OrderByID}), nil
+}
+
+// listBeersQuery builds the SQL query for listing a page of beers. The query
+// lists one more beer than the page size so the caller can tell if there is
+// a next page.
+func listBeersQuery(params *domain.ListBeersParams) (string, []interface{}, error) {
+	orderBy, err := listOrderBy(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var b queryBuilder
+	b.where(params)
+	if params.After != nil {
+		b.after(params.After, orderBy)
+	}
+
+	var columns []string
+	for _, o := range orderBy {
+		column := orderByColumns[o.Field]
+		if o.Descending {
+			column += " DESC"
+		}
+		columns = append(columns, column)
+	}
+
+	query := "SELECT " + beerColumns + " FROM BEERS" + b.String() +
+		" ORDER BY " + strings.Join(columns, ", ") +
+		" LIMIT " + b.arg(params.PageSize+1)
+	return query, b.args, nil
+}
+
+// countBeersQuery builds the SQL query for counting the beers matching the
+// filters for listing beers.
+func countBeersQuery(params *domain.ListBeersParams) (string, []interface{}) {
+	var b queryBuilder
+	b.where(params)
+	return "SELECT COUNT(*) FROM BEERS" + b.String(), b.args
+}
+
+// searchBeersQuery builds the SQL query for searching a page of beers, which
+// returns the beers with their scores. The query returns one more beer than
+// the page size so the caller can tell if there is a next page.
+func searchBeersQuery(params *domain.SearchBeersParams) (string, []interface{}) {
+	var b queryBuilder
+	tsquery := b.arg(searchTSQuery(params.Query))
+	text := b.arg(params.Query)
+	results := "SELECT " + beerColumns + ", " +
+		"ts_rank(search_vector, query)::FLOAT8 + word_similarity(" + text + ", search_text)::FLOAT8 AS score " +
+		"FROM BEERS, to_tsquery('simple', " + tsquery + ") AS query " +
+		"WHERE deleted_at IS NULL AND (search_vector @@ query OR " + text + " <% search_text)"
+
+	if params.After != nil {
+		score := b.arg(params.After.Score)
+		b.conditions = append(b.conditions, "(score < "+score+" OR (score = "+score+" AND id > "+b.arg(params.After.ID)+"))")
+	}
+
+	query := "SELECT " + beerColumns + ", score FROM (" + results + ") AS results" + b.String() +
+		" ORDER BY score DESC, id LIMIT " + b.arg(params.PageSize+1)
+	return query, b.args
+}
+
+// searchTSQuery returns the text search query matching words starting with
+// every term of the search query. The terms only hold letters and digits, so
+// the text search query cannot be malformed.
+func searchTSQuery(query string) string {
+	terms := domain.SearchTerms(query)
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// updateBeerQuery builds the SQL statement for updating a beer which is not
+// deleted, which sets the fields in the params, increments the version of the
+// beer and returns the updated beer.
+func updateBeerQuery(params *domain.UpdateBeerParams) (string, []interface{}) {
+	var b queryBuilder
+	b.conditions = append(b.conditions, "id = "+b.arg(params.ID))
+	if params.Version != nil {
+		b.conditions = append(b.conditions, "version = "+b.arg(*params.Version))
+	}
+	b.conditions = append(b.conditions, "deleted_at IS NULL")
+
+	set := []string{"version = version + 1"}
+	if params.Name != nil {
+		set = append(set, "name = "+b.arg(*params.Name))
+	}
+	if params.Type != nil {
+		set = append(set, "type = "+b.arg(int(*params.Type)))
+	}
+	if params.Brewer != nil {
+		set = append(set, "brewer = "+b.arg(*params.Brewer))
+	}
+	if params.Country != nil {
+		set = append(set, "country = "+b.arg(*params.Country))
+	}
+	if params.ABV != nil {
+		set = append(set, "abv = "+b.arg(*params.ABV))
+	}
+	if params.IBU != nil {
+		set = append(set, "ibu = "+b.arg(*params.IBU))
+	}
+	if params.SRM != nil {
+		set = append(set, "srm = "+b.arg(*params.SRM))
+	}
+	if params.VolumeML != nil {
+		set = append(set, "volume_ml = "+b.arg(*params.VolumeML))
+	}
+	if params.Description != nil {
+		set = append(set, "description = "+b.arg(*params.Description))
+	}
+
+	query := "UPDATE BEERS SET " + strings.Join(set, ", ") + b.String() +
+		" RETURNING " + beerColumns
+	return query, b.args
+}
+
+// batchCreateBeersQuery builds the SQL statement for creating a batch of beers
+// with the given IDs, which returns the created beers.
+func batchCreateBeersQuery(params *domain.BatchCreateBeersParams, ids []string) (string, []interface{}) {
+	var b queryBuilder
+	values := make([]string, 0, len(params.Beers))
+	for i, beer := range params.Beers {
+		values = append(values, "("+strings.Join([]string{
+			b.arg(ids[i]), b.arg(beer.Name), b.arg(int(beer.Type)), b.arg(beer.Brewer), b.arg(beer.Country),
+			b.arg(beer.ABV), b.arg(beer.IBU), b.arg(beer.SRM), b.arg(beer.VolumeML), b.arg(beer.Description), "1",
+		}, ", ")+")")
+	}
+	query := "INSERT INTO BEERS (id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version) VALUES " +
+		strings.Join(values, ", ") + " RETURNING " + beerColumns
+	return query, b.args
+}
+
+// escapeLike escapes the LIKE pattern characters in the given string.
+func escapeLike(in string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(in)
+}
+
 func toDomainBeer(in *postgresBeer) *domain.Beer {
-	return &domain.Beer{
-		ID:      in.ID,
-		Name:    in.Name,
-		Type:    domain.BeerType(in.Type),
-		Brewer:  in.Brewer,
-		Country: in.Country,
+	beer := &domain.Beer{
+		ID:          in.ID,
+		Name:        in.Name,
+		Type:        domain.BeerType(in.Type),
+		Brewer:      in.Brewer,
+		Country:     in.Country,
+		ABV:         in.ABV,
+		IBU:         in.IBU,
+		SRM:         in.SRM,
+		VolumeML:    in.VolumeML,
+		Description: in.Description,
+		Version:     in.Version,
+	}
+	if in.DeleteTime.Valid {
+		beer.DeleteTime = &in.DeleteTime.Time
 	}
+	return beer
 }
@@ -0,0 +1,431 @@
+// +build integration
+
+package infrastructure_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getenv returns the value of the environment variable, or the fallback if
+// it is not set.
+func getenv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+// postgresSettings returns the settings of the database described by the
+// POSTGRES_* environment variables, defaulting to the database in the README.
+func postgresSettings(t *testing.T) *infrastructure.PostgresSettings {
+	port, err := strconv.Atoi(getenv("POSTGRES_PORT", "5432"))
+	require.Nil(t, err)
+	return &infrastructure.PostgresSettings{
+		Host:     getenv("POSTGRES_HOST", "localhost"),
+		Port:     port,
+		User:     getenv("POSTGRES_USER", "postgres"),
+		Password: getenv("POSTGRES_PASSWORD", "ilovebeer"),
+		DBName:   getenv("POSTGRES_DBNAME", "beers"),
+	}
+}
+
+// openPostgres returns a connection pool to the database, closed when the
+// test completes.
+func openPostgres(t *testing.T) *sql.DB {
+	db, err := infrastructure.OpenPostgres(postgresSettings(t))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return db
+}
+
+// migratePostgres returns a connection pool to the database, migrating the
+// schema first.
+func migratePostgres(t *testing.T) *sql.DB {
+	db := openPostgres(t)
+	migrator, err := infrastructure.NewMigrator(db)
+	require.Nil(t, err)
+	_, err = migrator.Up(context.Background())
+	require.Nil(t, err)
+	return db
+}
+
+// newPostgresBeerRepository returns a repository connected to the database,
+// migrating the schema first.
+func newPostgresBeerRepository(t *testing.T) *infrastructure.PostgresBeerRepository {
+	return infrastructure.NewPostgresBeerRepository(migratePostgres(t), func() string {
+		return uuid.New().String()
+	})
+}
+
+func TestPostgresBeerRepository_UpdateBeer_UpdatesMaskedFields(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+	fields := []string{"name", "type", "brewer", "country", "abv", "ibu", "srm", "volume_ml", "description"}
+
+	// Every combination of the fields, including none.
+	for mask := 0; mask < 1<<len(fields); mask++ {
+		var paths []string
+		for i, field := range fields {
+			if mask&(1<<i) != 0 {
+				paths = append(paths, field)
+			}
+		}
+		t.Run(fmt.Sprintf("test mask [%s]", strings.Join(paths, ",")), func(s *testing.T) {
+			created, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{
+				Name:        "Orval",
+				Type:        domain.PaleAle,
+				Brewer:      "Brasserie d'Orval",
+				Country:     "Belgium",
+				ABV:         6.2,
+				IBU:         32,
+				SRM:         10.5,
+				VolumeML:    330,
+				Description: "Dry hopped Trappist ale.",
+			}, recordAt(time.Now()))
+			require.Nil(s, err)
+			defer repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID}, recordAt(time.Now()))
+
+			name, beerType, brewer, country := "Westmalle Tripel", domain.Ale, "Westmalle", "Netherlands"
+			abv, ibu, srm, volumeML, description := 9.5, 39, 4.5, 0, ""
+			params := &domain.UpdateBeerParams{ID: created.ID}
+			expected := *created
+			expected.Version++
+			for _, path := range paths {
+				switch path {
+				case "name":
+					params.Name = &name
+					expected.Name = name
+				case "type":
+					params.Type = &beerType
+					expected.Type = beerType
+				case "brewer":
+					params.Brewer = &brewer
+					expected.Brewer = brewer
+				case "country":
+					params.Country = &country
+					expected.Country = country
+				case "abv":
+					params.ABV = &abv
+					expected.ABV = abv
+				case "ibu":
+					params.IBU = &ibu
+					expected.IBU = ibu
+				case "srm":
+					params.SRM = &srm
+					expected.SRM = srm
+				case "volume_ml":
+					params.VolumeML = &volumeML
+					expected.VolumeML = volumeML
+				case "description":
+					params.Description = &description
+					expected.Description = description
+				}
+			}
+
+			updated, err := repo.UpdateBeer(ctx, params, recordAt(time.Now()))
+			assert.Nil(s, err)
+			assert.Equal(s, &expected, updated)
+
+			persisted, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: created.ID})
+			assert.Nil(s, err)
+			assert.Equal(s, &expected, persisted)
+		})
+	}
+}
+
+func TestPostgresBeerRepository_UpdateBeer_WhenVersionDoesNotMatch_ReturnsConflictError(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+	created, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "Orval"}, recordAt(time.Now()))
+	require.Nil(t, err)
+	defer repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID}, recordAt(time.Now()))
+
+	name := "Westmalle Tripel"
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: created.ID, Name: &name, Version: &created.Version}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: created.ID, Name: &name, Version: &created.Version}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewConflictError(fmt.Sprintf("beer '%s' has been modified", created.ID)), err)
+}
+
+func TestPostgresBeerRepository_UpdateBeer_WhenBeerDoesNotExist_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	name := "Westmalle Tripel"
+	version := int64(1)
+	tests := []struct {
+		name    string
+		version *int64
+	}{
+		{name: "without version"},
+		{name: "with version", version: &version},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := repo.UpdateBeer(context.Background(), &domain.UpdateBeerParams{ID: "missing", Name: &name, Version: test.version}, recordAt(time.Now()))
+			assert.Equal(s, domain.NewNotFoundError("beer 'missing' not found"), err)
+		})
+	}
+}
+
+func TestPostgresBeerRepository_DeleteBeer_DeletesBeerUntilPurged(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+	created, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "Orval"}, recordAt(time.Now()))
+	require.Nil(t, err)
+
+	deleteTime := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	deleted, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID, Version: &created.Version}, recordAt(deleteTime))
+	require.Nil(t, err)
+	assert.Equal(t, created.Version+1, deleted.Version)
+	require.NotNil(t, deleted.DeleteTime)
+	assert.True(t, deleteTime.Equal(*deleted.DeleteTime))
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: created.ID})
+	assert.Equal(t, domain.NewNotFoundError(fmt.Sprintf("beer '%s' not found", created.ID)), err)
+	persisted, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: created.ID, ShowDeleted: true})
+	require.Nil(t, err)
+	assert.Equal(t, deleted, persisted)
+
+	_, err = repo.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: created.ID, Version: &created.Version}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewConflictError(fmt.Sprintf("beer '%s' has been modified", created.ID)), err)
+	undeleted, err := repo.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: created.ID, Version: &deleted.Version}, recordAt(time.Now()))
+	require.Nil(t, err)
+	assert.Equal(t, &domain.Beer{ID: created.ID, Name: "Orval", Version: created.Version + 2}, undeleted)
+	_, err = repo.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: created.ID}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewFailedPreconditionError(fmt.Sprintf("beer '%s' is not deleted", created.ID)), err)
+
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID}, recordAt(deleteTime))
+	require.Nil(t, err)
+	purged, err := repo.PurgeBeers(ctx, deleteTime.Add(time.Second))
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, purged, 1)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: created.ID, ShowDeleted: true})
+	assert.Equal(t, domain.NewNotFoundError(fmt.Sprintf("beer '%s' not found", created.ID)), err)
+}
+
+func TestPostgresBeerRepository_RecordsAuditEntriesOfMutations(t *testing.T) {
+	t.Parallel()
+	db := migratePostgres(t)
+	generateID := func() string {
+		return uuid.New().String()
+	}
+	repo := infrastructure.NewPostgresBeerRepository(db, generateID)
+	audit := infrastructure.NewPostgresAuditRepository(db)
+	ctx := context.Background()
+	now := time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	created, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "Orval"}, recordAt(now))
+	require.Nil(t, err)
+	name := "Westmalle Tripel"
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: created.ID, Name: &name}, recordAt(now))
+	require.Nil(t, err)
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID, Version: &created.Version}, recordAt(now))
+	assert.Equal(t, domain.NewConflictError(fmt.Sprintf("beer '%s' has been modified", created.ID)), err)
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created.ID}, recordAt(now))
+	require.Nil(t, err)
+
+	deleteTime := now.Format(time.RFC3339Nano)
+	expected := []struct {
+		action  domain.AuditAction
+		changes []domain.FieldChange
+	}{
+		{action: domain.AuditActionCreate, changes: []domain.FieldChange{{Field: "name", After: "Orval"}}},
+		{action: domain.AuditActionUpdate, changes: []domain.FieldChange{{Field: "name", Before: "Orval", After: name}}},
+		{action: domain.AuditActionDelete, changes: []domain.FieldChange{{Field: "delete_time", After: deleteTime}}},
+	}
+	entries, err := audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: created.ID})
+	require.Nil(t, err)
+	require.Len(t, entries, len(expected))
+	for i, entry := range entries {
+		assert.NotEmpty(t, entry.ID)
+		assert.Equal(t, created.ID, entry.BeerID)
+		assert.Equal(t, expected[i].action, entry.Action)
+		assert.Equal(t, "subject", entry.Actor)
+		assert.Equal(t, "request", entry.RequestID)
+		assert.Equal(t, expected[i].changes, entry.Changes)
+		assert.True(t, now.Equal(entry.CreateTime))
+	}
+
+	imported, err := repo.ImportBeers(ctx, []*domain.Beer{{Name: "Orval"}}, recordAt(now))
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	entries, err = audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: imported[0].ID})
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, domain.AuditActionCreate, entries[0].Action)
+	assert.Equal(t, []domain.FieldChange{{Field: "name", After: "Orval"}}, entries[0].Changes)
+
+	batch, err := repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "Orval"}}},
+		recordAt(now))
+	require.Nil(t, err)
+	_, err = repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: batch[0].ID}}},
+		recordAt(now))
+	require.Nil(t, err)
+	entries, err = audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: batch[0].ID})
+	require.Nil(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, domain.AuditActionCreate, entries[0].Action)
+	assert.Equal(t, []domain.FieldChange{{Field: "name", After: "Orval"}}, entries[0].Changes)
+	assert.Equal(t, domain.AuditActionDelete, entries[1].Action)
+	assert.Equal(t, []domain.FieldChange{{Field: "delete_time", After: deleteTime}}, entries[1].Changes)
+}
+
+func TestPostgresBeerRepository_BatchDeleteBeers_DeletesAllBeersOrNone(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+	created, err := repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{
+		{Name: "Orval", Type: domain.Ale},
+		{Name: "Westmalle Tripel", Type: domain.Ale},
+	}}, recordAt(time.Now()))
+	require.Nil(t, err)
+	require.Len(t, created, 2)
+	assert.Equal(t, "Orval", created[0].Name)
+	assert.Equal(t, "Westmalle Tripel", created[1].Name)
+
+	got, err := repo.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: []string{created[1].ID, created[0].ID}})
+	require.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{created[1], created[0]}, got)
+
+	stale := int64(2)
+	deleteTime := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err = repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{
+		{ID: created[0].ID},
+		{ID: created[1].ID, Version: &stale},
+	}}, recordAt(deleteTime))
+	assert.Equal(t, domain.NewConflictError(fmt.Sprintf("beer '%s' has been modified", created[1].ID)), err)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: created[0].ID})
+	assert.Nil(t, err)
+
+	deleted, err := repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{
+		{ID: created[1].ID, Version: &created[1].Version},
+		{ID: created[0].ID},
+	}}, recordAt(deleteTime))
+	assert.Nil(t, err)
+	require.Len(t, deleted, 2)
+	assert.Equal(t, created[1].ID, deleted[0].ID)
+	assert.Equal(t, int64(2), deleted[0].Version)
+	assert.True(t, deleteTime.Equal(*deleted[0].DeleteTime))
+	assert.Equal(t, created[0].ID, deleted[1].ID)
+	_, err = repo.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: []string{created[0].ID}})
+	assert.Equal(t, domain.NewNotFoundError(fmt.Sprintf("beer '%s' not found", created[0].ID)), err)
+}
+
+func TestPostgresBeerRepository_SearchBeers_RanksMatchingBeers(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+
+	// The beers are found by a word unique to the test, as the database is
+	// shared with other tests.
+	word := "w" + strings.ReplaceAll(uuid.New().String(), "-", "")[:15]
+	created, err := repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{
+		{Name: "Dubbel", Type: domain.Ale, Brewer: word},
+		{Name: word + " Tripel", Type: domain.Ale, Brewer: "Westmalle"},
+	}}, recordAt(time.Now()))
+	require.Nil(t, err)
+
+	misspelled := word[:len(word)-1] + "x"
+	for _, query := range []string{word, strings.ToUpper(word[:8]), misspelled} {
+		result, err := repo.SearchBeers(ctx, &domain.SearchBeersParams{Query: query, PageSize: 10})
+		require.Nil(t, err)
+		require.Len(t, result.Results, 2, query)
+		assert.Equal(t, created[1], result.Results[0].Beer, query)
+		assert.Equal(t, created[0], result.Results[1].Beer, query)
+		assert.Greater(t, result.Results[0].Score, result.Results[1].Score, query)
+		assert.Nil(t, result.Next)
+	}
+
+	first, err := repo.SearchBeers(ctx, &domain.SearchBeersParams{Query: word, PageSize: 1})
+	require.Nil(t, err)
+	require.Len(t, first.Results, 1)
+	assert.Equal(t, &domain.Cursor{ID: created[1].ID, Score: first.Results[0].Score}, first.Next)
+	second, err := repo.SearchBeers(ctx, &domain.SearchBeersParams{Query: word, PageSize: 1, After: first.Next})
+	require.Nil(t, err)
+	require.Len(t, second.Results, 1)
+	assert.Equal(t, created[0], second.Results[0].Beer)
+	assert.Nil(t, second.Next)
+
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: created[0].ID}, recordAt(time.Now()))
+	require.Nil(t, err)
+	result, err := repo.SearchBeers(ctx, &domain.SearchBeersParams{Query: word, PageSize: 10})
+	require.Nil(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, created[1], result.Results[0].Beer)
+}
+
+func TestPostgresBeerRepository_ImportBeers_ImportsBeersWhichAreExported(t *testing.T) {
+	t.Parallel()
+	repo := newPostgresBeerRepository(t)
+	ctx := context.Background()
+	ids := []string{uuid.New().String(), uuid.New().String()}
+	beers := []*domain.Beer{
+		{ID: ids[0], Name: "Orval", Type: domain.Ale, Brewer: "Brasserie d'Orval", Country: "Belgium",
+			ABV: 6.2, IBU: 32, SRM: 10.5, VolumeML: 330, Description: "Dry hopped Trappist ale.", Version: 3},
+		{ID: ids[1], Name: "Westmalle Tripel", Type: domain.Ale},
+	}
+	created, err := repo.ImportBeers(ctx, beers, recordAt(time.Now()))
+	require.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{
+		{ID: ids[0], Name: "Orval", Type: domain.Ale, Brewer: "Brasserie d'Orval", Country: "Belgium",
+			ABV: 6.2, IBU: 32, SRM: 10.5, VolumeML: 330, Description: "Dry hopped Trappist ale.", Version: 1},
+		{ID: ids[1], Name: "Westmalle Tripel", Type: domain.Ale, Version: 1},
+	}, created)
+
+	// Importing the beers again skips them.
+	skipped, err := repo.ImportBeers(ctx, beers, recordAt(time.Now()))
+	require.Nil(t, err)
+	assert.Empty(t, skipped)
+
+	exported := map[string]*domain.Beer{}
+	var previous string
+	err = repo.ExportBeers(ctx, &domain.ExportBeersParams{}, func(beer *domain.Beer) error {
+		assert.Less(t, previous, beer.ID)
+		previous = beer.ID
+		exported[beer.ID] = beer
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, created[0], exported[ids[0]])
+	assert.Equal(t, created[1], exported[ids[1]])
+}
+
+func TestMigrator_Up_AppliesAllMigrations(t *testing.T) {
+	t.Parallel()
+	migrator, err := infrastructure.NewMigrator(openPostgres(t))
+	require.Nil(t, err)
+	ctx := context.Background()
+
+	_, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	applied, err := migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, applied)
+
+	statuses, err := migrator.Status(ctx)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, statuses)
+	for _, status := range statuses {
+		assert.NotNil(t, status.AppliedTime, "migration %d", status.Version)
+	}
+	assert.Nil(t, migrator.Check(ctx))
+}
@@ -0,0 +1,281 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBeersQuery(t *testing.T) {
+	t.Parallel()
+	stout := domain.Stout
+	tests := []struct {
+		name   string
+		params *domain.ListBeersParams
+		query  string
+		args   []interface{}
+	}{
+		{
+			name:   "no filters",
+			params: &domain.ListBeersParams{PageSize: 100},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE deleted_at IS NULL ORDER BY id LIMIT $1",
+			args:   []interface{}{101},
+		},
+		{
+			name:   "all filters",
+			params: &domain.ListBeersParams{PageSize: 10, Type: &stout, Brewer: "brewer", Country: "country", NamePrefix: "Old"},
+			query: "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS " +
+				"WHERE deleted_at IS NULL AND type = $1 AND brewer = $2 AND country = $3 AND name LIKE $4 " +
+				"ORDER BY id LIMIT $5",
+			args: []interface{}{int(domain.Stout), "brewer", "country", "Old%", 11},
+		},
+		{
+			name:   "name prefix with pattern characters",
+			params: &domain.ListBeersParams{PageSize: 10, NamePrefix: `100%_\`},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE deleted_at IS NULL AND name LIKE $1 ORDER BY id LIMIT $2",
+			args:   []interface{}{`100\%\_\\%`, 11},
+		},
+		{
+			name:   "order by",
+			params: &domain.ListBeersParams{PageSize: 10, OrderBy: "name desc,country"},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE deleted_at IS NULL ORDER BY name DESC, country, id LIMIT $1",
+			args:   []interface{}{11},
+		},
+		{
+			name:   "order by id",
+			params: &domain.ListBeersParams{PageSize: 10, OrderBy: "id desc"},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE deleted_at IS NULL ORDER BY id DESC LIMIT $1",
+			args:   []interface{}{11},
+		},
+		{
+			name:   "after cursor",
+			params: &domain.ListBeersParams{PageSize: 10, After: &domain.Cursor{ID: "id"}},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE deleted_at IS NULL AND ((id > $1)) ORDER BY id LIMIT $2",
+			args:   []interface{}{"id", 11},
+		},
+		{
+			name: "after cursor with filters and order by",
+			params: &domain.ListBeersParams{
+				PageSize: 10,
+				Brewer:   "brewer",
+				OrderBy:  "type desc,name",
+				After:    &domain.Cursor{ID: "id", Name: "name", Type: domain.Ale},
+			},
+			query: "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS " +
+				"WHERE deleted_at IS NULL AND brewer = $1 AND ((type < $2) OR (type = $2 AND name > $3) OR (type = $2 AND name = $3 AND id > $4)) " +
+				"ORDER BY type DESC, name, id LIMIT $5",
+			args: []interface{}{"brewer", int(domain.Ale), "name", "id", 11},
+		},
+		{
+			name:   "show deleted",
+			params: &domain.ListBeersParams{PageSize: 10, Brewer: "brewer", ShowDeleted: true},
+			query:  "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at FROM BEERS WHERE brewer = $1 ORDER BY id LIMIT $2",
+			args:   []interface{}{"brewer", 11},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			query, args, err := listBeersQuery(test.params)
+			assert.Nil(s, err)
+			assert.Equal(s, test.query, query)
+			assert.Equal(s, test.args, args)
+		})
+	}
+}
+
+func TestListBeersQuery_WhenOrderByInvalid_ReturnsError(t *testing.T) {
+	t.Parallel()
+	_, _, err := listBeersQuery(&domain.ListBeersParams{PageSize: 10, OrderBy: "colour"})
+	assert.Equal(t, domain.NewValidationError("invalid order by field: 'colour'"), err)
+}
+
+func TestCountBeersQuery(t *testing.T) {
+	t.Parallel()
+	query, args := countBeersQuery(&domain.ListBeersParams{
+		PageSize: 10,
+		Country:  "country",
+		After:    &domain.Cursor{ID: "id"},
+	})
+	assert.Equal(t, "SELECT COUNT(*) FROM BEERS WHERE deleted_at IS NULL AND country = $1", query)
+	assert.Equal(t, []interface{}{"country"}, args)
+}
+
+func TestUpdateBeerQuery(t *testing.T) {
+	t.Parallel()
+	name, brewer, country := "name", "brewer", "country"
+	stout := domain.Stout
+	version := int64(3)
+	abv, ibu, srm, volumeML, description := 6.2, 32, 10.5, 330, "description"
+	tests := []struct {
+		name   string
+		params *domain.UpdateBeerParams
+		query  string
+		args   []interface{}
+	}{
+		{
+			name:   "no fields",
+			params: &domain.UpdateBeerParams{ID: "id"},
+			query: "UPDATE BEERS SET version = version + 1 WHERE id = $1 AND deleted_at IS NULL " +
+				"RETURNING id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at",
+			args: []interface{}{"id"},
+		},
+		{
+			name:   "type",
+			params: &domain.UpdateBeerParams{ID: "id", Type: &stout},
+			query: "UPDATE BEERS SET version = version + 1, type = $2 WHERE id = $1 AND deleted_at IS NULL " +
+				"RETURNING id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at",
+			args: []interface{}{"id", int(domain.Stout)},
+		},
+		{
+			name:   "all fields with version",
+			params: &domain.UpdateBeerParams{ID: "id", Name: &name, Type: &stout, Brewer: &brewer, Country: &country, Version: &version},
+			query: "UPDATE BEERS SET version = version + 1, name = $3, type = $4, brewer = $5, country = $6 " +
+				"WHERE id = $1 AND version = $2 AND deleted_at IS NULL RETURNING id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at",
+			args: []interface{}{"id", int64(3), "name", int(domain.Stout), "brewer", "country"},
+		},
+		{
+			name: "attributes",
+			params: &domain.UpdateBeerParams{ID: "id", ABV: &abv, IBU: &ibu, SRM: &srm, VolumeML: &volumeML,
+				Description: &description},
+			query: "UPDATE BEERS SET version = version + 1, abv = $2, ibu = $3, srm = $4, volume_ml = $5, description = $6 " +
+				"WHERE id = $1 AND deleted_at IS NULL RETURNING id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at",
+			args: []interface{}{"id", 6.2, 32, 10.5, 330, "description"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			query, args := updateBeerQuery(test.params)
+			assert.Equal(s, test.query, query)
+			assert.Equal(s, test.args, args)
+		})
+	}
+}
+
+func TestBatchCreateBeersQuery(t *testing.T) {
+	t.Parallel()
+	params := &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{
+		{Name: "a", Type: domain.Ale, Brewer: "brewer", Country: "country", ABV: 6.2, IBU: 32, SRM: 10.5, VolumeML: 330, Description: "description"},
+		{Name: "b"},
+	}}
+	query, args := batchCreateBeersQuery(params, []string{"id1", "id2"})
+	assert.Equal(t, "INSERT INTO BEERS (id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 1), ($11, $12, $13, $14, $15, $16, $17, $18, $19, $20, 1) "+
+		"RETURNING id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at", query)
+	assert.Equal(t, []interface{}{
+		"id1", "a", int(domain.Ale), "brewer", "country", 6.2, 32, 10.5, 330, "description",
+		"id2", "b", 0, "", "", 0.0, 0, 0.0, 0, "",
+	}, args)
+}
+
+func TestSearchBeersQuery(t *testing.T) {
+	t.Parallel()
+	results := "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at, " +
+		"ts_rank(search_vector, query)::FLOAT8 + word_similarity($2, search_text)::FLOAT8 AS score " +
+		"FROM BEERS, to_tsquery('simple', $1) AS query " +
+		"WHERE deleted_at IS NULL AND (search_vector @@ query OR $2 <% search_text)"
+	tests := []struct {
+		name   string
+		params *domain.SearchBeersParams
+		query  string
+		args   []interface{}
+	}{
+		{
+			name:   "first page",
+			params: &domain.SearchBeersParams{Query: "Westmalle trip", PageSize: 10},
+			query: "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at, score FROM (" + results + ") AS results" +
+				" ORDER BY score DESC, id LIMIT $3",
+			args: []interface{}{"westmalle:* & trip:*", "Westmalle trip", 11},
+		},
+		{
+			name:   "page after cursor",
+			params: &domain.SearchBeersParams{Query: "orval", PageSize: 5, After: &domain.Cursor{ID: "id1", Score: 0.5}},
+			query: "SELECT id, name, type, brewer, country, abv, ibu, srm, volume_ml, description, version, deleted_at, score FROM (" + results + ") AS results" +
+				" WHERE (score < $3 OR (score = $3 AND id > $4)) ORDER BY score DESC, id LIMIT $5",
+			args: []interface{}{"orval:*", "orval", 0.5, "id1", 6},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			query, args := searchBeersQuery(test.params)
+			assert.Equal(s, test.query, query)
+			assert.Equal(s, test.args, args)
+		})
+	}
+}
+
+func TestSearchTSQuery_MatchesPrefixesOfEveryTerm(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "chimay:* & bleue:*", searchTSQuery("Chimay: 'Bleue' & chimay!"))
+}
+
+func TestOrderBeers(t *testing.T) {
+	t.Parallel()
+	a, b := &domain.Beer{ID: "a"}, &domain.Beer{ID: "b"}
+	ordered, err := orderBeers([]*domain.Beer{a, b}, []string{"b", "a", "b"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{b, a, b}, ordered)
+
+	_, err = orderBeers([]*domain.Beer{a}, []string{"a", "c"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'c' not found"), err)
+}
+
+func TestContextError(t *testing.T) {
+	t.Parallel()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now())
+	defer cancel()
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		err      error
+		expected error
+	}{
+		{
+			name:     "context canceled",
+			ctx:      canceled,
+			err:      &pq.Error{Code: queryCanceled, Message: "canceling statement due to user request"},
+			expected: context.Canceled,
+		},
+		{
+			name:     "context deadline exceeded",
+			ctx:      expired,
+			err:      &pq.Error{Code: queryCanceled, Message: "canceling statement due to user request"},
+			expected: context.DeadlineExceeded,
+		},
+		{
+			name:     "statement timeout",
+			ctx:      context.Background(),
+			err:      &pq.Error{Code: queryCanceled, Message: "canceling statement due to statement timeout"},
+			expected: context.DeadlineExceeded,
+		},
+		{
+			name: "other error",
+			ctx:  context.Background(),
+			err:  errors.New("something went wrong"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			err := contextError(test.ctx, test.err)
+			if test.expected == nil {
+				assert.Nil(s, err)
+				assert.Equal(s, test.err, queryError(test.ctx, test.err))
+			} else {
+				assert.True(s, errors.Is(err, test.expected))
+				assert.Equal(s, err, queryError(test.ctx, test.err))
+			}
+		})
+	}
+}
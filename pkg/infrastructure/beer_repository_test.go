@@ -1 +1,49 @@
 package infrastructure_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresSettings_String(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		settings infrastructure.PostgresSettings
+		expected string
+	}{
+		{
+			name:     "default sslmode",
+			settings: infrastructure.PostgresSettings{Host: "localhost", Port: 5432, User: "postgres", Password: "secret", DBName: "beers"},
+			expected: "host=localhost port=5432 user=postgres password=secret dbname=beers sslmode=disable",
+		},
+		{
+			name:     "sslmode",
+			settings: infrastructure.PostgresSettings{Host: "localhost", Port: 5432, User: "postgres", DBName: "beers", SSLMode: "require"},
+			expected: "host=localhost port=5432 user=postgres password= dbname=beers sslmode=require",
+		},
+		{
+			name: "sslrootcert",
+			settings: infrastructure.PostgresSettings{Host: "db", Port: 5433, User: "postgres", DBName: "beers",
+				SSLMode: "verify-full", SSLRootCert: "/etc/ssl/ca.pem"},
+			expected: "host=db port=5433 user=postgres password= dbname=beers sslmode=verify-full sslrootcert=/etc/ssl/ca.pem",
+		},
+		{
+			name: "statement timeout",
+			settings: infrastructure.PostgresSettings{Host: "db", Port: 5432, User: "postgres", DBName: "beers",
+				StatementTimeout: 2500 * time.Millisecond, MaxOpenConns: 10},
+			expected: "host=db port=5432 user=postgres password= dbname=beers sslmode=disable statement_timeout=2500",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.Equal(s, test.expected, test.settings.String())
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TLSSettings describes the files holding the certificates of a TLS server.
+type TLSSettings struct {
+	// CertFile and KeyFile hold the PEM encoded certificate chain and
+	// private key of the server.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile holds the PEM encoded certificates of the CAs which sign
+	// client certificates. If not empty, clients must present a certificate
+	// signed by one of them.
+	ClientCAFile string
+}
+
+// NewCertificateReloader creates a new certificate reloader which loads the
+// certificates in the files given by the settings.
+func NewCertificateReloader(logger *logrus.Logger, settings *TLSSettings) (*CertificateReloader, error) {
+	r := &CertificateReloader{
+		logger:   logger,
+		settings: *settings,
+		modTimes: make(map[string]time.Time),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CertificateReloader holds the certificates of a TLS server and reloads them
+// when the files holding them change on disk, so that certificates can be
+// rotated without restarting the server. It is safe for concurrent use.
+type CertificateReloader struct {
+	logger   *logrus.Logger
+	settings TLSSettings
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// files returns the files holding the certificates.
+func (r *CertificateReloader) files() []string {
+	files := []string{r.settings.CertFile, r.settings.KeyFile}
+	if r.settings.ClientCAFile != "" {
+		files = append(files, r.settings.ClientCAFile)
+	}
+	return files
+}
+
+// load loads the certificates from the files.
+func (r *CertificateReloader) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.settings.CertFile, r.settings.KeyFile)
+	if err != nil {
+		return fmt.Errorf("error loading certificate: %w", err)
+	}
+	var clientCAs *x509.CertPool
+	if r.settings.ClientCAFile != "" {
+		clientCAs, err = loadCertPool(r.settings.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("error loading client CAs: %w", err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return nil
+}
+
+// changed returns true if any of the files changed since they were loaded.
+func (r *CertificateReloader) changed() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reload reloads the certificates if any of the files changed since they
+// were loaded. The current certificates are kept if the files cannot be
+// loaded, for instance while they are only partly written.
+func (r *CertificateReloader) Reload() error {
+	if !r.changed() {
+		return nil
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	r.logger.Infof("reloaded certificate '%s'", r.settings.CertFile)
+	return nil
+}
+
+// Run checks the files for changes every interval until the context is done.
+func (r *CertificateReloader) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				r.logger.Errorf("error reloading certificate '%s': %v", r.settings.CertFile, err)
+			}
+		}
+	}
+}
+
+// Certificate returns the current certificate.
+func (r *CertificateReloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// ServerConfig returns a TLS config for servers which present the current
+// certificate. If verifyClients is true and there is a client CA file,
+// clients must present a certificate signed by one of the current client CAs.
+func (r *CertificateReloader) ServerConfig(verifyClients bool) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+	if verifyClients && r.settings.ClientCAFile != "" {
+		// Client certificates are verified against the current client CAs
+		// rather than a fixed pool in the config, so that the client CAs
+		// can be reloaded.
+		config.ClientAuth = tls.RequireAnyClientCert
+		config.VerifyPeerCertificate = r.verifyClientCertificate
+	}
+	return config
+}
+
+// verifyClientCertificate verifies the client certificate chain was signed by
+// one of the current client CAs.
+func (r *CertificateReloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = cert
+	}
+	if len(certs) == 0 {
+		return errors.New("no client certificate")
+	}
+
+	r.mu.RLock()
+	clientCAs := r.clientCAs
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+// ClientConfig returns a TLS config for clients which verify servers with the
+// CAs in the CA file, or the system CAs if empty, and present the current
+// certificate if the server asks for one.
+func (r *CertificateReloader) ClientConfig(caFile, serverName string) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+	if caFile != "" {
+		rootCAs, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading CAs: %w", err)
+		}
+		config.RootCAs = rootCAs
+	}
+	return config, nil
+}
+
+// loadCertPool loads a pool of the PEM encoded certificates in the file.
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(file) // #nosec G304 file is provided by the operator.
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificates found")
+	}
+	return pool, nil
+}
@@ -0,0 +1,255 @@
+package infrastructure_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCert is a certificate and its private key.
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newTestCert creates a certificate for the common name signed by the parent,
+// or self-signed CA certificate if the parent is nil.
+func newTestCert(t *testing.T, commonName string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// write writes the certificate and private key to cert.pem and key.pem in
+// the directory with the modification time, so that tests need not wait for
+// the file system clock to tick for reloads to notice changes.
+func (c *testCert) write(t *testing.T, dir string, modTime time.Time) {
+	key, err := x509.MarshalECPrivateKey(c.key)
+	assert.Nil(t, err)
+	writeTestFile(t, filepath.Join(dir, "cert.pem"), c.pem, modTime)
+	writeTestFile(t, filepath.Join(dir, "key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}), modTime)
+}
+
+func writeTestFile(t *testing.T, path string, content []byte, modTime time.Time) {
+	assert.Nil(t, ioutil.WriteFile(path, content, 0600))
+	assert.Nil(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "certs")
+	assert.Nil(t, err)
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	return logger
+}
+
+// handshake performs a TLS handshake between a client and server with the
+// configs, returning the error of the server.
+func handshake(t *testing.T, clientConfig, serverConfig *tls.Config) error {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer lis.Close()
+
+	go func() {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+		if err == nil {
+			// Wait for the server to verify the client before closing.
+			_, _ = conn.Read(make([]byte, 1))
+			conn.Close()
+		}
+	}()
+
+	conn, err := lis.Accept()
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Nil(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+	return tls.Server(conn, serverConfig).Handshake()
+}
+
+func TestNewCertificateReloader_WhenFilesDoNotExist_ReturnsError(t *testing.T) {
+	t.Parallel()
+	_, err := infrastructure.NewCertificateReloader(newTestLogger(), &infrastructure.TLSSettings{
+		CertFile: "does-not-exist.pem",
+		KeyFile:  "does-not-exist.pem",
+	})
+	assert.NotNil(t, err)
+}
+
+func TestCertificateReloader_Reload_WhenFilesChanged_ReloadsCertificate(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := newTestDir(t)
+	defer cleanup()
+
+	ca := newTestCert(t, "ca", nil)
+	first := newTestCert(t, "first", ca)
+	first.write(t, dir, time.Now())
+	reloader, err := infrastructure.NewCertificateReloader(newTestLogger(), &infrastructure.TLSSettings{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, first.cert.Raw, reloader.Certificate().Certificate[0])
+
+	assert.Nil(t, reloader.Reload())
+	assert.Equal(t, first.cert.Raw, reloader.Certificate().Certificate[0])
+
+	second := newTestCert(t, "second", ca)
+	second.write(t, dir, time.Now().Add(time.Minute))
+	assert.Nil(t, reloader.Reload())
+	assert.Equal(t, second.cert.Raw, reloader.Certificate().Certificate[0])
+}
+
+func TestCertificateReloader_Reload_WhenFilesInvalid_KeepsCertificate(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := newTestDir(t)
+	defer cleanup()
+
+	cert := newTestCert(t, "localhost", nil)
+	cert.write(t, dir, time.Now())
+	reloader, err := infrastructure.NewCertificateReloader(newTestLogger(), &infrastructure.TLSSettings{
+		CertFile: filepath.Join(dir, "cert.pem"),
+		KeyFile:  filepath.Join(dir, "key.pem"),
+	})
+	assert.Nil(t, err)
+
+	writeTestFile(t, filepath.Join(dir, "cert.pem"), []byte("partly written"), time.Now().Add(time.Minute))
+	assert.NotNil(t, reloader.Reload())
+	assert.Equal(t, cert.cert.Raw, reloader.Certificate().Certificate[0])
+}
+
+func TestCertificateReloader_ServerConfig_VerifiesClientCertificates(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := newTestDir(t)
+	defer cleanup()
+
+	ca := newTestCert(t, "ca", nil)
+	server := newTestCert(t, "localhost", ca)
+	server.write(t, dir, time.Now())
+	clientCAFile := filepath.Join(dir, "ca.pem")
+	writeTestFile(t, clientCAFile, ca.pem, time.Now())
+	reloader, err := infrastructure.NewCertificateReloader(newTestLogger(), &infrastructure.TLSSettings{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCAFile: clientCAFile,
+	})
+	assert.Nil(t, err)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.cert)
+	clientConfig := func(client *testCert) *tls.Config {
+		config := &tls.Config{RootCAs: rootCAs, ServerName: "localhost"}
+		if client != nil {
+			config.Certificates = []tls.Certificate{{Certificate: [][]byte{client.cert.Raw}, PrivateKey: client.key}}
+		}
+		return config
+	}
+	tests := []struct {
+		name          string
+		client        *testCert
+		verifyClients bool
+		valid         bool
+	}{
+		{
+			name:          "trusted client",
+			client:        newTestCert(t, "client", ca),
+			verifyClients: true,
+			valid:         true,
+		},
+		{
+			name:          "untrusted client",
+			client:        newTestCert(t, "client", newTestCert(t, "other ca", nil)),
+			verifyClients: true,
+		},
+		{
+			name:          "no client certificate",
+			verifyClients: true,
+		},
+		{
+			name:  "clients not verified",
+			valid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			err := handshake(s, clientConfig(test.client), reloader.ServerConfig(test.verifyClients))
+			if test.valid {
+				assert.Nil(s, err)
+			} else {
+				assert.NotNil(s, err)
+			}
+		})
+	}
+}
+
+func TestCertificateReloader_ClientConfig_PresentsCertificate(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := newTestDir(t)
+	defer cleanup()
+
+	ca := newTestCert(t, "ca", nil)
+	cert := newTestCert(t, "localhost", ca)
+	cert.write(t, dir, time.Now())
+	caFile := filepath.Join(dir, "ca.pem")
+	writeTestFile(t, caFile, ca.pem, time.Now())
+	reloader, err := infrastructure.NewCertificateReloader(newTestLogger(), &infrastructure.TLSSettings{
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		ClientCAFile: caFile,
+	})
+	assert.Nil(t, err)
+
+	clientConfig, err := reloader.ClientConfig(caFile, "localhost")
+	assert.Nil(t, err)
+	assert.Nil(t, handshake(t, clientConfig, reloader.ServerConfig(true)))
+
+	_, err = reloader.ClientConfig(filepath.Join(dir, "key.pem"), "localhost")
+	assert.EqualError(t, err, "error loading CAs: no certificates found")
+}
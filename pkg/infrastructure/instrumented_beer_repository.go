@@ -0,0 +1,132 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/usecases"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// NewInstrumentedBeerRepository creates a new beer repository which records
+// the latency and errors of calls to the given repository. The metrics are
+// registered with the registerer.
+func NewInstrumentedBeerRepository(repo usecases.BeerRepository,
+	registerer prometheus.Registerer) (*InstrumentedBeerRepository, error) {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "beer_repository_call_duration_seconds",
+		Help:    "Latency of beer repository calls.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+	errors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "beer_repository_call_errors_total",
+		Help: "Total number of beer repository calls which returned an error.",
+	}, []string{"method"})
+	for _, c := range []prometheus.Collector{duration, errors} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return &InstrumentedBeerRepository{
+		repo:     repo,
+		duration: duration,
+		errors:   errors,
+	}, nil
+}
+
+// InstrumentedBeerRepository is a beer repository which records metrics for
+// the calls to the beer repository it decorates.
+type InstrumentedBeerRepository struct {
+	repo     usecases.BeerRepository
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// observe records the duration of the call to method started at start and
+// whether it returned an error.
+func (repo *InstrumentedBeerRepository) observe(method string, start time.Time, err error) {
+	repo.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		repo.errors.WithLabelValues(method).Inc()
+	}
+}
+
+// CreateBeer creates a beer.
+func (repo *InstrumentedBeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams, audit *domain.AuditRecord) (beer *domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("CreateBeer", start, err) }(time.Now())
+	return repo.repo.CreateBeer(ctx, params, audit)
+}
+
+// GetBeer gets a beer.
+func (repo *InstrumentedBeerRepository) GetBeer(ctx context.Context, params *domain.GetBeerParams) (beer *domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("GetBeer", start, err) }(time.Now())
+	return repo.repo.GetBeer(ctx, params)
+}
+
+// UpdateBeer updates a beer.
+func (repo *InstrumentedBeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams, audit *domain.AuditRecord) (beer *domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("UpdateBeer", start, err) }(time.Now())
+	return repo.repo.UpdateBeer(ctx, params, audit)
+}
+
+// DeleteBeer deletes a beer.
+func (repo *InstrumentedBeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams, audit *domain.AuditRecord) (beer *domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("DeleteBeer", start, err) }(time.Now())
+	return repo.repo.DeleteBeer(ctx, params, audit)
+}
+
+// UndeleteBeer undeletes a beer.
+func (repo *InstrumentedBeerRepository) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams, audit *domain.AuditRecord) (beer *domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("UndeleteBeer", start, err) }(time.Now())
+	return repo.repo.UndeleteBeer(ctx, params, audit)
+}
+
+// PurgeBeers purges deleted beers.
+func (repo *InstrumentedBeerRepository) PurgeBeers(ctx context.Context, deletedBefore time.Time) (purged int, err error) {
+	defer func(start time.Time) { repo.observe("PurgeBeers", start, err) }(time.Now())
+	return repo.repo.PurgeBeers(ctx, deletedBefore)
+}
+
+// BatchGetBeers gets a batch of beers.
+func (repo *InstrumentedBeerRepository) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) (beers []*domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("BatchGetBeers", start, err) }(time.Now())
+	return repo.repo.BatchGetBeers(ctx, params)
+}
+
+// BatchCreateBeers creates a batch of beers.
+func (repo *InstrumentedBeerRepository) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams, audit *domain.AuditRecord) (beers []*domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("BatchCreateBeers", start, err) }(time.Now())
+	return repo.repo.BatchCreateBeers(ctx, params, audit)
+}
+
+// BatchDeleteBeers deletes a batch of beers.
+func (repo *InstrumentedBeerRepository) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams, audit *domain.AuditRecord) (beers []*domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("BatchDeleteBeers", start, err) }(time.Now())
+	return repo.repo.BatchDeleteBeers(ctx, params, audit)
+}
+
+// ListBeers lists a page of beers.
+func (repo *InstrumentedBeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) (result *domain.ListBeersResult, err error) {
+	defer func(start time.Time) { repo.observe("ListBeers", start, err) }(time.Now())
+	return repo.repo.ListBeers(ctx, params)
+}
+
+// SearchBeers searches a page of beers.
+func (repo *InstrumentedBeerRepository) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (result *domain.SearchBeersResult, err error) {
+	defer func(start time.Time) { repo.observe("SearchBeers", start, err) }(time.Now())
+	return repo.repo.SearchBeers(ctx, params)
+}
+
+// ExportBeers exports every beer.
+func (repo *InstrumentedBeerRepository) ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) (err error) {
+	defer func(start time.Time) { repo.observe("ExportBeers", start, err) }(time.Now())
+	return repo.repo.ExportBeers(ctx, params, handler)
+}
+
+// ImportBeers imports a batch of beers.
+func (repo *InstrumentedBeerRepository) ImportBeers(ctx context.Context, beers []*domain.Beer, audit *domain.AuditRecord) (created []*domain.Beer, err error) {
+	defer func(start time.Time) { repo.observe("ImportBeers", start, err) }(time.Now())
+	return repo.repo.ImportBeers(ctx, beers, audit)
+}
@@ -0,0 +1,70 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+	"github.com/bvwells/grpc-gateway-example/pkg/usecases/mocks"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// sampleCount returns the total number of observations of the histogram with
+// the name.
+func sampleCount(t *testing.T, registry *prometheus.Registry, name string) int {
+	families, err := registry.Gather()
+	assert.Nil(t, err)
+	count := 0
+	for _, family := range families {
+		if family.GetName() == name {
+			for _, metric := range family.GetMetric() {
+				count += int(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return count
+}
+
+func TestInstrumentedBeerRepository_RecordsCallsAndErrors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := &mocks.BeerRepository{}
+	repo.On("GetBeer", ctx, &domain.GetBeerParams{ID: "id"}).Return(&domain.Beer{ID: "id"}, nil)
+	audit := &domain.AuditRecord{Time: time.Now()}
+	repo.On("DeleteBeer", ctx, &domain.DeleteBeerParams{ID: "id"}, audit).Return(nil, errors.New("error"))
+
+	registry := prometheus.NewRegistry()
+	instrumented, err := infrastructure.NewInstrumentedBeerRepository(repo, registry)
+	assert.Nil(t, err)
+
+	beer, err := instrumented.GetBeer(ctx, &domain.GetBeerParams{ID: "id"})
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Beer{ID: "id"}, beer)
+	_, err = instrumented.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id"}, audit)
+	assert.Equal(t, errors.New("error"), err)
+
+	assert.Equal(t, 2, sampleCount(t, registry, "beer_repository_call_duration_seconds"))
+	expected := `
+# HELP beer_repository_call_errors_total Total number of beer repository calls which returned an error.
+# TYPE beer_repository_call_errors_total counter
+beer_repository_call_errors_total{method="DeleteBeer"} 1
+`
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "beer_repository_call_errors_total"))
+	repo.AssertExpectations(t)
+}
+
+func TestNewInstrumentedBeerRepository_WhenAlreadyRegistered_ReturnsError(t *testing.T) {
+	t.Parallel()
+	registry := prometheus.NewRegistry()
+	_, err := infrastructure.NewInstrumentedBeerRepository(&mocks.BeerRepository{}, registry)
+	assert.Nil(t, err)
+	_, err = infrastructure.NewInstrumentedBeerRepository(&mocks.BeerRepository{}, registry)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,108 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewMemoryAPIKeyRepository creates a new in-memory API key repository.
+func NewMemoryAPIKeyRepository(generateID GenerateID) *MemoryAPIKeyRepository {
+	return &MemoryAPIKeyRepository{
+		keys:       make(map[string]domain.APIKey),
+		generateID: generateID,
+	}
+}
+
+// MemoryAPIKeyRepository is an in-memory API key repository. It is safe for
+// concurrent use and is intended for local development and tests.
+type MemoryAPIKeyRepository struct {
+	mu         sync.RWMutex
+	keys       map[string]domain.APIKey
+	generateID func() string
+}
+
+// CreateAPIKey creates an API key in memory.
+func (repo *MemoryAPIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	created := copyAPIKey(key)
+	created.ID = repo.generateID()
+	if _, ok := repo.keys[created.ID]; ok {
+		return nil, domain.NewAlreadyExistsError(fmt.Sprintf("api key '%s' already exists", created.ID))
+	}
+	for _, k := range repo.keys {
+		if string(k.Hash) == string(created.Hash) {
+			return nil, domain.NewAlreadyExistsError("api key with hash already exists")
+		}
+	}
+	repo.keys[created.ID] = *created
+	return copyAPIKey(created), nil
+}
+
+// GetAPIKeyByHash gets an API key with the hash from memory.
+func (repo *MemoryAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	for _, key := range repo.keys {
+		if string(key.Hash) == string(hash) {
+			return copyAPIKey(&key), nil
+		}
+	}
+	return nil, domain.NewNotFoundError("api key not found")
+}
+
+// ListAPIKeys lists all API keys in memory, ordered by ID.
+func (repo *MemoryAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	keys := make([]*domain.APIKey, 0, len(repo.keys))
+	for _, key := range repo.keys {
+		keys = append(keys, copyAPIKey(&key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+	return keys, nil
+}
+
+// RevokeAPIKey revokes an API key in memory. Revoking a revoked API key keeps
+// the original revoke time.
+func (repo *MemoryAPIKeyRepository) RevokeAPIKey(ctx context.Context, params *domain.RevokeAPIKeyParams, revokeTime time.Time) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	key, ok := repo.keys[params.ID]
+	if !ok {
+		return apiKeyNotFound(params.ID)
+	}
+	if key.RevokeTime == nil {
+		key.RevokeTime = &revokeTime
+		repo.keys[key.ID] = key
+	}
+	return nil
+}
+
+// copyAPIKey returns a deep copy of the API key, so that callers cannot
+// modify the API keys held in memory.
+func copyAPIKey(in *domain.APIKey) *domain.APIKey {
+	out := *in
+	out.Hash = append([]byte(nil), in.Hash...)
+	out.Scopes = append([]domain.Permission(nil), in.Scopes...)
+	if in.RevokeTime != nil {
+		revokeTime := *in.RevokeTime
+		out.RevokeTime = &revokeTime
+	}
+	return &out
+}
+
+func apiKeyNotFound(id string) error {
+	return domain.NewNotFoundError(fmt.Sprintf("api key '%s' not found", id))
+}
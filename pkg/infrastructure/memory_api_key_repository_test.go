@@ -0,0 +1,111 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAPIKey(key string) *domain.APIKey {
+	return &domain.APIKey{
+		Name:       "name",
+		Hash:       domain.HashAPIKey(key),
+		Scopes:     []domain.Permission{domain.PermissionListBeers},
+		CreateTime: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		ExpireTime: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestMemoryAPIKeyRepository_CreateAPIKey_ReturnsCreatedAPIKey(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	ctx := context.Background()
+	expected := newAPIKey("key")
+	expected.ID = "id01"
+	actual, err := repo.CreateAPIKey(ctx, newAPIKey("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	actual, err = repo.GetAPIKeyByHash(ctx, domain.HashAPIKey("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryAPIKeyRepository_CreateAPIKey_WhenHashExists_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	ctx := context.Background()
+	_, err := repo.CreateAPIKey(ctx, newAPIKey("key"))
+	assert.Nil(t, err)
+	_, err = repo.CreateAPIKey(ctx, newAPIKey("key"))
+	assert.Equal(t, domain.NewAlreadyExistsError("api key with hash already exists"), err)
+}
+
+func TestMemoryAPIKeyRepository_GetAPIKeyByHash_WhenAPIKeyDoesNotExist_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	_, err := repo.GetAPIKeyByHash(context.Background(), domain.HashAPIKey("key"))
+	assert.Equal(t, domain.NewNotFoundError("api key not found"), err)
+}
+
+func TestMemoryAPIKeyRepository_GetAPIKeyByHash_ReturnsCopy(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	ctx := context.Background()
+	_, err := repo.CreateAPIKey(ctx, newAPIKey("key"))
+	assert.Nil(t, err)
+	key, err := repo.GetAPIKeyByHash(ctx, domain.HashAPIKey("key"))
+	assert.Nil(t, err)
+	key.Scopes[0] = domain.PermissionDeleteBeers
+	key, err = repo.GetAPIKeyByHash(ctx, domain.HashAPIKey("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.Permission{domain.PermissionListBeers}, key.Scopes)
+}
+
+func TestMemoryAPIKeyRepository_ListAPIKeys_ReturnsAPIKeysOrderedByID(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	ctx := context.Background()
+	keys, err := repo.ListAPIKeys(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	for _, key := range []string{"a", "b", "c"} {
+		_, err := repo.CreateAPIKey(ctx, newAPIKey(key))
+		assert.Nil(t, err)
+	}
+	keys, err = repo.ListAPIKeys(ctx)
+	assert.Nil(t, err)
+	var ids []string
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+	}
+	assert.Equal(t, []string{"id01", "id02", "id03"}, ids)
+}
+
+func TestMemoryAPIKeyRepository_RevokeAPIKey_RevokesAPIKey(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	ctx := context.Background()
+	_, err := repo.CreateAPIKey(ctx, newAPIKey("key"))
+	assert.Nil(t, err)
+
+	revokeTime := time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, repo.RevokeAPIKey(ctx, &domain.RevokeAPIKeyParams{ID: "id01"}, revokeTime))
+	assert.Nil(t, repo.RevokeAPIKey(ctx, &domain.RevokeAPIKeyParams{ID: "id01"}, revokeTime.Add(time.Hour)))
+
+	key, err := repo.GetAPIKeyByHash(ctx, domain.HashAPIKey("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, &revokeTime, key.RevokeTime)
+}
+
+func TestMemoryAPIKeyRepository_RevokeAPIKey_WhenAPIKeyDoesNotExist_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAPIKeyRepository(newIDGenerator())
+	err := repo.RevokeAPIKey(context.Background(), &domain.RevokeAPIKeyParams{ID: "id"}, time.Now())
+	assert.Equal(t, domain.NewNotFoundError("api key 'id' not found"), err)
+}
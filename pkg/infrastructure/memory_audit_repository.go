@@ -0,0 +1,75 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewMemoryAuditRepository creates a new in-memory audit repository.
+func NewMemoryAuditRepository(generateID GenerateID) *MemoryAuditRepository {
+	return &MemoryAuditRepository{
+		ids:        make(map[string]bool),
+		generateID: generateID,
+	}
+}
+
+// MemoryAuditRepository is an in-memory audit repository. It is safe for
+// concurrent use and is intended for local development and tests.
+type MemoryAuditRepository struct {
+	mu sync.RWMutex
+	// entries are the audit entries in the order they were created.
+	entries    []domain.AuditEntry
+	ids        map[string]bool
+	generateID func() string
+}
+
+// appendEntries appends the audit entries of mutations of beers, assigning
+// them IDs. Either every entry is appended or, if an entry cannot be, none
+// are.
+func (repo *MemoryAuditRepository) appendEntries(entries ...*domain.AuditEntry) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	appended := make([]domain.AuditEntry, 0, len(entries))
+	ids := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		entry := copyAuditEntry(entry)
+		entry.ID = repo.generateID()
+		if repo.ids[entry.ID] || ids[entry.ID] {
+			return domain.NewAlreadyExistsError(fmt.Sprintf("audit entry '%s' already exists", entry.ID))
+		}
+		ids[entry.ID] = true
+		appended = append(appended, *entry)
+	}
+	for id := range ids {
+		repo.ids[id] = true
+	}
+	repo.entries = append(repo.entries, appended...)
+	return nil
+}
+
+// ListAuditEntries lists the audit entries of a beer in memory in the order
+// they were created.
+func (repo *MemoryAuditRepository) ListAuditEntries(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	entries := []*domain.AuditEntry{}
+	for _, entry := range repo.entries {
+		if entry.BeerID == params.ID {
+			entries = append(entries, copyAuditEntry(&entry))
+		}
+	}
+	return entries, nil
+}
+
+// copyAuditEntry returns a deep copy of the audit entry, so that callers
+// cannot modify the audit entries held in memory.
+func copyAuditEntry(in *domain.AuditEntry) *domain.AuditEntry {
+	out := *in
+	out.Changes = append([]domain.FieldChange(nil), in.Changes...)
+	return &out
+}
@@ -0,0 +1,53 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryAuditRepository_ListAuditEntries_ReturnsEntriesOfBeerInOrder(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAuditRepository(newIDGenerator())
+	beers := infrastructure.NewMemoryBeerRepository(newIDGenerator(), repo)
+	ctx := context.Background()
+	_, err := beers.CreateBeer(ctx, &domain.CreateBeerParams{Name: "beer"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = beers.CreateBeer(ctx, &domain.CreateBeerParams{Name: "another beer"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	name := "new name"
+	_, err = beers.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = beers.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+
+	entries, err := repo.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "id01"})
+	assert.Nil(t, err)
+	var ids []string
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	assert.Equal(t, []string{"id01", "id03", "id04"}, ids)
+
+	entries, err = repo.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "missing"})
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestMemoryAuditRepository_ListAuditEntries_ReturnsCopies(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryAuditRepository(newIDGenerator())
+	beers := infrastructure.NewMemoryBeerRepository(newIDGenerator(), repo)
+	ctx := context.Background()
+	_, err := beers.CreateBeer(ctx, &domain.CreateBeerParams{Name: "beer"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	entries, _ := repo.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "id01"})
+	entries[0].Changes[0].After = "changed"
+	entries, _ = repo.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "id01"})
+	assert.Equal(t, "beer", entries[0].Changes[0].After)
+}
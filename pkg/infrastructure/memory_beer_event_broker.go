@@ -0,0 +1,181 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewMemoryBeerEventBroker creates a new in-memory beer event broker which
+// keeps the given number of the most recent events for resuming watches. The
+// resume tokens of the broker are only valid for the broker, watches resumed
+// from the tokens of another broker, e.g. of a previous run of the gateway,
+// fail as if the token has expired.
+func NewMemoryBeerEventBroker(history int, generateID GenerateID) *MemoryBeerEventBroker {
+	if history < 1 {
+		history = 1
+	}
+	return &MemoryBeerEventBroker{
+		epoch:     generateID(),
+		events:    make([]domain.BeerEvent, history),
+		versions:  make(map[string]int64),
+		next:      1,
+		published: make(chan struct{}),
+		closed:    make(chan struct{}),
+	}
+}
+
+// MemoryBeerEventBroker is an in-memory beer event broker delivering events to
+// watchers in the same process. It is safe for concurrent use.
+type MemoryBeerEventBroker struct {
+	mu    sync.Mutex
+	epoch string
+	// events are the most recent events, the event with sequence number seq
+	// is at seq modulo the number of events.
+	events []domain.BeerEvent
+	// versions are the latest versions of the beers of the events kept.
+	versions map[string]int64
+	// next is the sequence number of the next published event, starting from
+	// 1.
+	next int64
+	// published is closed, and replaced, whenever an event is published.
+	published chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Close closes the broker, ending all watches.
+func (b *MemoryBeerEventBroker) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+	return nil
+}
+
+// Publish publishes the event to the watchers. Mutations are published after
+// they are committed, so the events of concurrent mutations of a beer can be
+// published out of order. An event of a beer with a version no newer than the
+// version of a kept event of the beer is dropped, without a resume token, so
+// that watchers never see a beer go back to an older version.
+func (b *MemoryBeerEventBroker) Publish(ctx context.Context, event *domain.BeerEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if event.Beer != nil {
+		if version, ok := b.versions[event.Beer.ID]; ok && event.Beer.Version <= version {
+			return
+		}
+	}
+
+	seq := b.next
+	b.next++
+	event.ResumeToken = b.resumeToken(seq)
+	published := *event
+	if event.Beer != nil {
+		beer := *event.Beer
+		published.Beer = &beer
+	}
+	i := seq % int64(len(b.events))
+	if evicted := b.events[i].Beer; evicted != nil && b.versions[evicted.ID] == evicted.Version {
+		delete(b.versions, evicted.ID)
+	}
+	b.events[i] = published
+	if published.Beer != nil {
+		b.versions[published.Beer.ID] = published.Beer.Version
+	}
+
+	close(b.published)
+	b.published = make(chan struct{})
+}
+
+// Watch calls the handler with the published events until the context is
+// done, the handler returns an error or the broker is closed, in which case
+// nil is returned. Watches which fall so far behind that the events they have
+// not yet been called with are no longer kept fail, as do watches resumed
+// from such an event.
+func (b *MemoryBeerEventBroker) Watch(ctx context.Context, params *domain.WatchBeersParams, handler func(*domain.BeerEvent) error) error {
+	b.mu.Lock()
+	next := b.next
+	b.mu.Unlock()
+	if params.ResumeToken != "" {
+		seq, err := b.parseResumeToken(params.ResumeToken)
+		if err != nil {
+			return err
+		}
+		next = seq + 1
+	}
+
+	resumed := params.ResumeToken != ""
+	for {
+		b.mu.Lock()
+		if next < b.next-int64(len(b.events)) {
+			b.mu.Unlock()
+			if resumed {
+				return domain.NewFailedPreconditionError(fmt.Sprintf("resume token '%s' has expired", params.ResumeToken))
+			}
+			return domain.NewFailedPreconditionError("watch has fallen too far behind")
+		}
+		pending := make([]domain.BeerEvent, 0, b.next-next)
+		for seq := next; seq < b.next; seq++ {
+			pending = append(pending, b.events[seq%int64(len(b.events))])
+		}
+		published := b.published
+		b.mu.Unlock()
+
+		for i := range pending {
+			if err := handler(&pending[i]); err != nil {
+				return err
+			}
+			next++
+			resumed = false
+		}
+		if len(pending) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-b.closed:
+			return nil
+		case <-published:
+		}
+	}
+}
+
+// resumeToken returns the resume token of the event with the sequence number.
+func (b *MemoryBeerEventBroker) resumeToken(seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(b.epoch + "/" + strconv.FormatInt(seq, 10)))
+}
+
+// parseResumeToken returns the sequence number of the event the resume token
+// was returned for.
+func (b *MemoryBeerEventBroker) parseResumeToken(token string) (int64, error) {
+	invalid := domain.NewValidationError(fmt.Sprintf("invalid resume token '%s'", token))
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, invalid
+	}
+	i := strings.LastIndex(string(decoded), "/")
+	if i < 0 {
+		return 0, invalid
+	}
+	seq, err := strconv.ParseInt(string(decoded[i+1:]), 10, 64)
+	if err != nil || seq < 1 {
+		return 0, invalid
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if string(decoded[:i]) != b.epoch {
+		return 0, domain.NewFailedPreconditionError(fmt.Sprintf("resume token '%s' has expired", token))
+	}
+	if seq >= b.next {
+		return 0, invalid
+	}
+	return seq, nil
+}
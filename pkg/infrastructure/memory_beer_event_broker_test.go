@@ -0,0 +1,236 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStop = errors.New("stop")
+
+// version is the version of the beer of the last event published by publish.
+var version int64
+
+// publish publishes events of the change of beers with the IDs, each to a
+// newer version than the last, and returns the events.
+func publish(broker *infrastructure.MemoryBeerEventBroker, ids ...string) []*domain.BeerEvent {
+	var events []*domain.BeerEvent
+	for _, id := range ids {
+		beer := &domain.Beer{ID: id, Version: atomic.AddInt64(&version, 1)}
+		event := &domain.BeerEvent{Type: domain.BeerUpdated, Beer: beer}
+		broker.Publish(context.Background(), event)
+		events = append(events, event)
+	}
+	return events
+}
+
+// watch watches the broker from the resume token until the given number of
+// events have been received.
+func watch(broker *infrastructure.MemoryBeerEventBroker, resumeToken string, n int) ([]string, error) {
+	var ids []string
+	err := broker.Watch(context.Background(), &domain.WatchBeersParams{ResumeToken: resumeToken},
+		func(event *domain.BeerEvent) error {
+			ids = append(ids, event.Beer.ID)
+			if len(ids) == n {
+				return errStop
+			}
+			return nil
+		})
+	return ids, err
+}
+
+func TestMemoryBeerEventBroker_Publish_SetsResumeToken(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(10, newIDGenerator())
+	events := publish(broker, "a", "b")
+	assert.NotEmpty(t, events[0].ResumeToken)
+	assert.NotEqual(t, events[0].ResumeToken, events[1].ResumeToken)
+}
+
+func TestMemoryBeerEventBroker_Watch_WithoutResumeToken_WatchesEventsPublishedAfterWatchStarts(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(1000, newIDGenerator())
+	publish(broker, "before")
+
+	// Events are published until the watch receives one, as the watch may
+	// not have started when the first events are published.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				publish(broker, "after")
+			}
+		}
+	}()
+
+	ids, err := watch(broker, "", 1)
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"after"}, ids)
+}
+
+func TestMemoryBeerEventBroker_Watch_WithResumeToken_WatchesEventsAfterResumeToken(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(10, newIDGenerator())
+	events := publish(broker, "a", "b", "c")
+
+	ids, err := watch(broker, events[0].ResumeToken, 2)
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"b", "c"}, ids)
+}
+
+func TestMemoryBeerEventBroker_Publish_DropsEventsOlderThanPublishedEventOfBeer(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(10, newIDGenerator())
+	events := publish(broker, "start")
+	newer := &domain.BeerEvent{Type: domain.BeerUpdated, Beer: &domain.Beer{ID: "a", Version: 3}}
+	broker.Publish(context.Background(), newer)
+	for _, v := range []int64{2, 3} {
+		older := &domain.BeerEvent{Type: domain.BeerUpdated, Beer: &domain.Beer{ID: "a", Version: v}}
+		broker.Publish(context.Background(), older)
+		assert.Empty(t, older.ResumeToken)
+	}
+	publish(broker, "b")
+
+	var versions []string
+	err := broker.Watch(context.Background(), &domain.WatchBeersParams{ResumeToken: events[0].ResumeToken},
+		func(event *domain.BeerEvent) error {
+			versions = append(versions, fmt.Sprintf("%s/%d", event.Beer.ID, event.Beer.Version))
+			if event.Beer.ID == "b" {
+				return errStop
+			}
+			return nil
+		})
+	assert.Equal(t, errStop, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "a/3", versions[0])
+}
+
+func TestMemoryBeerEventBroker_Publish_WhenEventOfBeerNoLongerKept_PublishesEvent(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(1, newIDGenerator())
+	broker.Publish(context.Background(), &domain.BeerEvent{Type: domain.BeerUpdated, Beer: &domain.Beer{ID: "a", Version: 3}})
+	publish(broker, "b")
+	event := &domain.BeerEvent{Type: domain.BeerCreated, Beer: &domain.Beer{ID: "a", Version: 1}}
+	broker.Publish(context.Background(), event)
+	assert.NotEmpty(t, event.ResumeToken)
+}
+
+func TestMemoryBeerEventBroker_Watch_WatchesEventsPublishedWhileWatching(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(10, newIDGenerator())
+	events := publish(broker, "start", "a")
+
+	var ids []string
+	err := broker.Watch(context.Background(), &domain.WatchBeersParams{ResumeToken: events[0].ResumeToken},
+		func(event *domain.BeerEvent) error {
+			ids = append(ids, event.Beer.ID)
+			if event.Beer.ID == "a" {
+				publish(broker, "b")
+				return nil
+			}
+			return errStop
+		})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
+
+func TestMemoryBeerEventBroker_Watch_ReturnsCopiesOfEvents(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(10, newIDGenerator())
+	events := publish(broker, "a", "b")
+	events[1].Beer.ID = "changed"
+
+	ids, err := watch(broker, events[0].ResumeToken, 1)
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"b"}, ids)
+}
+
+func TestMemoryBeerEventBroker_Watch_WhenResumeTokenExpired_ReturnsFailedPreconditionError(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	events := publish(broker, "a", "b", "c", "d")
+
+	ids, err := watch(broker, events[1].ResumeToken, 2)
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"c", "d"}, ids)
+
+	_, err = watch(broker, events[0].ResumeToken, 1)
+	assert.Equal(t, domain.NewFailedPreconditionError(fmt.Sprintf("resume token '%s' has expired", events[0].ResumeToken)), err)
+
+	other := infrastructure.NewMemoryBeerEventBroker(2, func() string { return "other" })
+	_, err = watch(other, events[3].ResumeToken, 1)
+	assert.Equal(t, domain.NewFailedPreconditionError(fmt.Sprintf("resume token '%s' has expired", events[3].ResumeToken)), err)
+}
+
+func TestMemoryBeerEventBroker_Watch_WhenResumeTokenInvalid_ReturnsValidationError(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	events := publish(broker, "a")
+
+	other := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	publish(other, "a", "b")
+	otherEvents := publish(other, "c")
+
+	// The token of the other broker is from the future, as both brokers
+	// have the same epoch.
+	for _, token := range []string{"token", "!", events[0].ResumeToken + "x", otherEvents[0].ResumeToken} {
+		_, err := watch(broker, token, 1)
+		assert.Equal(t, domain.NewValidationError(fmt.Sprintf("invalid resume token '%s'", token)), err)
+	}
+}
+
+func TestMemoryBeerEventBroker_Watch_WhenFallenTooFarBehind_ReturnsFailedPreconditionError(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	events := publish(broker, "start", "a")
+
+	var ids []string
+	err := broker.Watch(context.Background(), &domain.WatchBeersParams{ResumeToken: events[0].ResumeToken},
+		func(event *domain.BeerEvent) error {
+			ids = append(ids, event.Beer.ID)
+			publish(broker, "b", "c", "d")
+			return nil
+		})
+	assert.Equal(t, domain.NewFailedPreconditionError("watch has fallen too far behind"), err)
+	assert.Equal(t, []string{"a"}, ids)
+}
+
+func TestMemoryBeerEventBroker_Watch_WhenContextDone_ReturnsContextError(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := broker.Watch(ctx, &domain.WatchBeersParams{}, func(event *domain.BeerEvent) error {
+		return nil
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestMemoryBeerEventBroker_Close_EndsWatches(t *testing.T) {
+	t.Parallel()
+	broker := infrastructure.NewMemoryBeerEventBroker(2, newIDGenerator())
+	errs := make(chan error)
+	go func() {
+		errs <- broker.Watch(context.Background(), &domain.WatchBeersParams{}, func(event *domain.BeerEvent) error {
+			return nil
+		})
+	}()
+	require.Nil(t, broker.Close())
+	assert.Nil(t, <-errs)
+	assert.Nil(t, broker.Close())
+}
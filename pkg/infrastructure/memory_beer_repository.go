@@ -0,0 +1,484 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// NewMemoryBeerRepository creates a new in-memory beer repository which
+// records the audit entries of the mutations of beers in the audit
+// repository.
+func NewMemoryBeerRepository(generateID GenerateID, audit *MemoryAuditRepository) *MemoryBeerRepository {
+	return &MemoryBeerRepository{
+		beers:      make(map[string]domain.Beer),
+		audit:      audit,
+		generateID: generateID,
+	}
+}
+
+// MemoryBeerRepository is an in-memory beer repository. It is safe for
+// concurrent use and is intended for local development and tests. The audit
+// entries of mutations are recorded while the beers are locked, and a
+// mutation is only applied once its audit entry is recorded.
+type MemoryBeerRepository struct {
+	mu         sync.RWMutex
+	beers      map[string]domain.Beer
+	audit      *MemoryAuditRepository
+	generateID func() string
+}
+
+// Ping pings the in-memory repository, which is always reachable.
+func (repo *MemoryBeerRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// CreateBeer creates a beer in memory.
+func (repo *MemoryBeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	beer := domain.Beer{
+		ID:          repo.generateID(),
+		Name:        params.Name,
+		Type:        params.Type,
+		Brewer:      params.Brewer,
+		Country:     params.Country,
+		ABV:         params.ABV,
+		IBU:         params.IBU,
+		SRM:         params.SRM,
+		VolumeML:    params.VolumeML,
+		Description: params.Description,
+		Version:     1,
+	}
+	if _, ok := repo.beers[beer.ID]; ok {
+		return nil, domain.NewAlreadyExistsError(fmt.Sprintf("beer '%s' already exists", beer.ID))
+	}
+	if err := repo.audit.appendEntries(audit.Entry(domain.AuditActionCreate, nil, &beer)); err != nil {
+		return nil, err
+	}
+	repo.beers[beer.ID] = beer
+	return &beer, nil
+}
+
+// GetBeer gets a beer from memory.
+func (repo *MemoryBeerRepository) GetBeer(ctx context.Context, params *domain.GetBeerParams) (*domain.Beer, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	beer, ok := repo.beers[params.ID]
+	if !ok || (beer.DeleteTime != nil && !params.ShowDeleted) {
+		return nil, beerNotFound(params.ID)
+	}
+	return &beer, nil
+}
+
+// UpdateBeer updates a beer in memory.
+func (repo *MemoryBeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	beer, ok := repo.beers[params.ID]
+	if !ok || beer.DeleteTime != nil {
+		return nil, beerNotFound(params.ID)
+	}
+	if params.Version != nil && *params.Version != beer.Version {
+		return nil, beerModified(params.ID)
+	}
+	before := beer
+	if params.Name != nil {
+		beer.Name = *params.Name
+	}
+	if params.Type != nil {
+		beer.Type = *params.Type
+	}
+	if params.Brewer != nil {
+		beer.Brewer = *params.Brewer
+	}
+	if params.Country != nil {
+		beer.Country = *params.Country
+	}
+	if params.ABV != nil {
+		beer.ABV = *params.ABV
+	}
+	if params.IBU != nil {
+		beer.IBU = *params.IBU
+	}
+	if params.SRM != nil {
+		beer.SRM = *params.SRM
+	}
+	if params.VolumeML != nil {
+		beer.VolumeML = *params.VolumeML
+	}
+	if params.Description != nil {
+		beer.Description = *params.Description
+	}
+	beer.Version++
+	if err := repo.audit.appendEntries(audit.Entry(domain.AuditActionUpdate, &before, &beer)); err != nil {
+		return nil, err
+	}
+	repo.beers[beer.ID] = beer
+	return &beer, nil
+}
+
+// DeleteBeer marks a beer in memory as deleted at the time of the audit
+// record.
+func (repo *MemoryBeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	beer, ok := repo.beers[params.ID]
+	if !ok || beer.DeleteTime != nil {
+		return nil, beerNotFound(params.ID)
+	}
+	if params.Version != nil && *params.Version != beer.Version {
+		return nil, beerModified(params.ID)
+	}
+	before := beer
+	deleteTime := audit.Time
+	beer.DeleteTime = &deleteTime
+	beer.Version++
+	if err := repo.audit.appendEntries(audit.Entry(domain.AuditActionDelete, &before, &beer)); err != nil {
+		return nil, err
+	}
+	repo.beers[beer.ID] = beer
+	return &beer, nil
+}
+
+// UndeleteBeer undeletes a deleted beer in memory.
+func (repo *MemoryBeerRepository) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	beer, ok := repo.beers[params.ID]
+	if !ok {
+		return nil, beerNotFound(params.ID)
+	}
+	if beer.DeleteTime == nil {
+		return nil, beerNotDeleted(params.ID)
+	}
+	if params.Version != nil && *params.Version != beer.Version {
+		return nil, beerModified(params.ID)
+	}
+	before := beer
+	beer.DeleteTime = nil
+	beer.Version++
+	if err := repo.audit.appendEntries(audit.Entry(domain.AuditActionUndelete, &before, &beer)); err != nil {
+		return nil, err
+	}
+	repo.beers[beer.ID] = beer
+	return &beer, nil
+}
+
+// PurgeBeers permanently removes the beers deleted before the given time
+// from memory.
+func (repo *MemoryBeerRepository) PurgeBeers(ctx context.Context, deletedBefore time.Time) (int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	purged := 0
+	for id, beer := range repo.beers {
+		if beer.DeleteTime != nil && beer.DeleteTime.Before(deletedBefore) {
+			delete(repo.beers, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// BatchGetBeers gets a batch of beers from memory.
+func (repo *MemoryBeerRepository) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error) {
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	beers := make([]*domain.Beer, 0, len(params.IDs))
+	for _, id := range params.IDs {
+		beer, ok := repo.beers[id]
+		if !ok || beer.DeleteTime != nil {
+			return nil, beerNotFound(id)
+		}
+		beers = append(beers, &beer)
+	}
+	return beers, nil
+}
+
+// BatchCreateBeers creates a batch of beers in memory and records their audit
+// entries, so either every beer is created or none are.
+func (repo *MemoryBeerRepository) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	beers := make([]*domain.Beer, 0, len(params.Beers))
+	entries := make([]*domain.AuditEntry, 0, len(params.Beers))
+	created := make(map[string]bool, len(params.Beers))
+	for _, beerParams := range params.Beers {
+		beer := domain.Beer{
+			ID:          repo.generateID(),
+			Name:        beerParams.Name,
+			Type:        beerParams.Type,
+			Brewer:      beerParams.Brewer,
+			Country:     beerParams.Country,
+			ABV:         beerParams.ABV,
+			IBU:         beerParams.IBU,
+			SRM:         beerParams.SRM,
+			VolumeML:    beerParams.VolumeML,
+			Description: beerParams.Description,
+			Version:     1,
+		}
+		if _, ok := repo.beers[beer.ID]; ok || created[beer.ID] {
+			return nil, domain.NewAlreadyExistsError("a beer in the batch already exists")
+		}
+		created[beer.ID] = true
+		beers = append(beers, &beer)
+		entries = append(entries, audit.Entry(domain.AuditActionCreate, nil, &beer))
+	}
+	if err := repo.audit.appendEntries(entries...); err != nil {
+		return nil, err
+	}
+	for _, beer := range beers {
+		repo.beers[beer.ID] = *beer
+	}
+	return beers, nil
+}
+
+// BatchDeleteBeers marks a batch of beers in memory as deleted and records
+// their audit entries. The beers are checked before any of them is deleted.
+func (repo *MemoryBeerRepository) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	deleted := make([]*domain.Beer, 0, len(params.Beers))
+	entries := make([]*domain.AuditEntry, 0, len(params.Beers))
+	for _, beerParams := range params.Beers {
+		before, ok := repo.beers[beerParams.ID]
+		if !ok || before.DeleteTime != nil {
+			return nil, beerNotFound(beerParams.ID)
+		}
+		if beerParams.Version != nil && *beerParams.Version != before.Version {
+			return nil, beerModified(beerParams.ID)
+		}
+		beer := before
+		deleteTime := audit.Time
+		beer.DeleteTime = &deleteTime
+		beer.Version++
+		deleted = append(deleted, &beer)
+		entries = append(entries, audit.Entry(domain.AuditActionDelete, &before, &beer))
+	}
+	if err := repo.audit.appendEntries(entries...); err != nil {
+		return nil, err
+	}
+	for _, beer := range deleted {
+		repo.beers[beer.ID] = *beer
+	}
+	return deleted, nil
+}
+
+// ListBeers lists a page of beers from memory.
+func (repo *MemoryBeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error) {
+	orderBy, err := listOrderBy(params)
+	if err != nil {
+		return nil, err
+	}
+
+	repo.mu.RLock()
+	defer repo.mu.RUnlock()
+
+	var matches []*domain.Beer
+	for _, beer := range repo.beers {
+		if matchesListBeersParams(&beer, params) {
+			beer := beer
+			matches = append(matches, &beer)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return compareCursors(domain.NewCursor(matches[i]), domain.NewCursor(matches[j]), orderBy) < 0
+	})
+
+	start := 0
+	if params.After != nil {
+		start = sort.Search(len(matches), func(i int) bool {
+			return compareCursors(domain.NewCursor(matches[i]), params.After, orderBy) > 0
+		})
+	}
+
+	result := &domain.ListBeersResult{
+		Beers:     matches[start:],
+		TotalSize: len(matches),
+	}
+	if len(result.Beers) > params.PageSize {
+		result.Beers = result.Beers[:params.PageSize]
+		result.Next = domain.NewCursor(result.Beers[params.PageSize-1])
+	}
+	return result, nil
+}
+
+// SearchBeers searches the beers in memory, ranking the beers by how well the
+// words of their name, brewer and country match the search terms.
+func (repo *MemoryBeerRepository) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error) {
+	terms := domain.SearchTerms(params.Query)
+
+	repo.mu.RLock()
+	var results []*domain.SearchResult
+	for _, beer := range repo.beers {
+		if beer.DeleteTime != nil {
+			continue
+		}
+		if score := searchScore(&beer, terms); score > 0 {
+			beer := beer
+			results = append(results, &domain.SearchResult{Beer: &beer, Score: score})
+		}
+	}
+	repo.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		return searchedBefore(searchCursor(results[i]), searchCursor(results[j]))
+	})
+
+	start := 0
+	if params.After != nil {
+		start = sort.Search(len(results), func(i int) bool {
+			return searchedBefore(params.After, searchCursor(results[i]))
+		})
+	}
+
+	result := &domain.SearchBeersResult{Results: results[start:]}
+	if len(result.Results) > params.PageSize {
+		result.Results = result.Results[:params.PageSize]
+		result.Next = searchCursor(result.Results[params.PageSize-1])
+	}
+	return result, nil
+}
+
+// ExportBeers calls the handler with every beer in memory in ID order. The
+// beers are copied before the handler is first called so that the export is
+// a snapshot of the beers.
+func (repo *MemoryBeerRepository) ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error {
+	repo.mu.RLock()
+	var beers []*domain.Beer
+	for _, beer := range repo.beers {
+		if beer.DeleteTime == nil || params.ShowDeleted {
+			beer := beer
+			beers = append(beers, &beer)
+		}
+	}
+	repo.mu.RUnlock()
+
+	sort.Slice(beers, func(i, j int) bool {
+		return beers[i].ID < beers[j].ID
+	})
+	for _, beer := range beers {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := handler(beer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportBeers creates the beers which do not already exist in memory, and
+// records their audit entries, so either every beer is imported or none are.
+func (repo *MemoryBeerRepository) ImportBeers(ctx context.Context, beers []*domain.Beer, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+
+	var (
+		created  []*domain.Beer
+		entries  []*domain.AuditEntry
+		imported = map[string]bool{}
+	)
+	for _, beer := range beers {
+		beer := *beer
+		if beer.ID == "" {
+			beer.ID = repo.generateID()
+		}
+		if _, ok := repo.beers[beer.ID]; ok || imported[beer.ID] {
+			continue
+		}
+		beer.Version = 1
+		imported[beer.ID] = true
+		created = append(created, &beer)
+		entries = append(entries, audit.Entry(domain.AuditActionCreate, nil, &beer))
+	}
+	if err := repo.audit.appendEntries(entries...); err != nil {
+		return nil, err
+	}
+	for _, beer := range created {
+		repo.beers[beer.ID] = *beer
+	}
+	return created, nil
+}
+
+// matchesListBeersParams returns true if the beer matches the filters for
+// listing beers.
+func matchesListBeersParams(beer *domain.Beer, params *domain.ListBeersParams) bool {
+	return (beer.DeleteTime == nil || params.ShowDeleted) &&
+		(params.Type == nil || beer.Type == *params.Type) &&
+		(params.Brewer == "" || beer.Brewer == params.Brewer) &&
+		(params.Country == "" || beer.Country == params.Country) &&
+		strings.HasPrefix(beer.Name, params.NamePrefix)
+}
+
+// compareCursors compares two cursors in the given order, returning a negative
+// number if a comes before b, a positive number if a comes after b and zero if
+// they are equal.
+func compareCursors(a, b *domain.Cursor, orderBy []domain.OrderBy) int {
+	for _, o := range orderBy {
+		var c int
+		if o.Field == domain.OrderByType {
+			c = int(a.Type) - int(b.Type)
+		} else {
+			c = strings.Compare(cursorValue(a, o.Field).(string), cursorValue(b, o.Field).(string))
+		}
+		if o.Descending {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// searchWeights are the weights of the name, brewer and country of beers when
+// ranking search results, the default weights of the A, B and C labels of
+// postgres text search.
+var searchWeights = [...]float64{1, 0.4, 0.2}
+
+// searchScore returns the mean over the search terms of the best weighted
+// match of the term with a word of the name, brewer or country of the beer.
+func searchScore(beer *domain.Beer, terms []string) float64 {
+	var total float64
+	for _, term := range terms {
+		var best float64
+		for i, field := range []string{beer.Name, beer.Brewer, beer.Country} {
+			for _, word := range domain.SearchTerms(field) {
+				if score := searchWeights[i] * domain.MatchTerm(word, term); score > best {
+					best = score
+				}
+			}
+		}
+		total += best
+	}
+	return total / float64(len(terms))
+}
+
+// searchCursor returns a cursor positioned at the search result.
+func searchCursor(result *domain.SearchResult) *domain.Cursor {
+	return &domain.Cursor{ID: result.Beer.ID, Score: result.Score}
+}
+
+// searchedBefore returns true if a comes before b in search results, which are
+// ordered by descending score and then by ID.
+func searchedBefore(a, b *domain.Cursor) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.ID < b.ID
+}
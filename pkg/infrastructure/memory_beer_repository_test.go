@@ -0,0 +1,774 @@
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newIDGenerator() infrastructure.GenerateID {
+	var (
+		mu sync.Mutex
+		id int
+	)
+	return func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		id++
+		return fmt.Sprintf("id%02d", id)
+	}
+}
+
+// recordAt returns the audit record of mutations at the given time.
+func recordAt(t time.Time) *domain.AuditRecord {
+	return &domain.AuditRecord{Actor: "subject", RequestID: "request", Time: t}
+}
+
+func newMemoryBeerRepository(t *testing.T, beers ...*domain.CreateBeerParams) *infrastructure.MemoryBeerRepository {
+	repo := infrastructure.NewMemoryBeerRepository(newIDGenerator(), infrastructure.NewMemoryAuditRepository(newIDGenerator()))
+	for _, beer := range beers {
+		_, err := repo.CreateBeer(context.Background(), beer, recordAt(time.Now()))
+		assert.Nil(t, err)
+	}
+	return repo
+}
+
+// deleteBeer deletes the beer with the given ID at the delete time.
+func deleteBeer(t *testing.T, repo *infrastructure.MemoryBeerRepository, id string, deleteTime time.Time) {
+	_, err := repo.DeleteBeer(context.Background(), &domain.DeleteBeerParams{ID: id}, recordAt(deleteTime))
+	assert.Nil(t, err)
+}
+
+func TestMemoryBeerRepository_CreateBeer_ReturnsCreatedBeer(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	ctx := context.Background()
+	expected := &domain.Beer{ID: "id01", Name: "name", Type: domain.Ale, Brewer: "brewer", Country: "country",
+		ABV: 6.2, IBU: 32, SRM: 10.5, VolumeML: 330, Description: "description", Version: 1}
+	actual, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name", Type: domain.Ale, Brewer: "brewer", Country: "country",
+		ABV: 6.2, IBU: 32, SRM: 10.5, VolumeML: 330, Description: "description"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	actual, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryBeerRepository_GetBeer_WhenBeerDoesNotExist_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	_, err := repo.GetBeer(context.Background(), &domain.GetBeerParams{ID: "id"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'id' not found"), err)
+}
+
+func TestMemoryBeerRepository_GetBeer_ReturnsCopy(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	beer, _ := repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	beer.Name = "changed"
+	actual, _ := repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Equal(t, "name", actual.Name)
+}
+
+func TestMemoryBeerRepository_UpdateBeer_UpdatesSpecifiedFields(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name", Type: domain.Ale, Brewer: "brewer", Country: "country",
+		ABV: 6.2, IBU: 32})
+	ctx := context.Background()
+	name := "new name"
+	beerType := domain.Stout
+	abv, srm, volumeML, description := 6.9, 10.5, 330, "description"
+	expected := &domain.Beer{ID: "id01", Name: name, Type: beerType, Brewer: "brewer", Country: "country",
+		ABV: abv, IBU: 32, SRM: srm, VolumeML: volumeML, Description: description, Version: 2}
+	actual, err := repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name, Type: &beerType,
+		ABV: &abv, SRM: &srm, VolumeML: &volumeML, Description: &description}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	actual, _ = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryBeerRepository_UpdateBeer_WhenBeerDoesNotExist_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	name := "name"
+	_, err := repo.UpdateBeer(context.Background(), &domain.UpdateBeerParams{ID: "id", Name: &name}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewNotFoundError("beer 'id' not found"), err)
+}
+
+func TestMemoryBeerRepository_UpdateBeer_WhenVersionMatches_UpdatesBeer(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	name := "new name"
+	version := int64(1)
+	actual, err := repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name, Version: &version}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Beer{ID: "id01", Name: name, Version: 2}, actual)
+}
+
+func TestMemoryBeerRepository_UpdateBeer_WhenVersionDoesNotMatch_ReturnsConflictError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	name := "new name"
+	version := int64(1)
+	_, err := repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name, Version: &version}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewConflictError("beer 'id01' has been modified"), err)
+}
+
+func TestMemoryBeerRepository_DeleteBeer_WhenVersionDoesNotMatch_ReturnsConflictError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	version := int64(2)
+	_, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01", Version: &version}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewConflictError("beer 'id01' has been modified"), err)
+	version = 1
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01", Version: &version}, recordAt(time.Now()))
+	assert.Nil(t, err)
+}
+
+func TestMemoryBeerRepository_DeleteBeer_DeletesBeer(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	deleteTime := time.Now()
+	expected := &domain.Beer{ID: "id01", Name: "name", Version: 2, DeleteTime: &deleteTime}
+	actual, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(deleteTime))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'id01' not found"), err)
+	actual, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01", ShowDeleted: true})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryBeerRepository_RecordsAuditEntriesOfMutations(t *testing.T) {
+	t.Parallel()
+	audit := infrastructure.NewMemoryAuditRepository(newIDGenerator())
+	repo := infrastructure.NewMemoryBeerRepository(newIDGenerator(), audit)
+	ctx := context.Background()
+	now := time.Now()
+	_, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name"}, recordAt(now))
+	assert.Nil(t, err)
+	name := "new name"
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name}, recordAt(now))
+	assert.Nil(t, err)
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(now))
+	assert.Nil(t, err)
+	_, err = repo.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: "id01"}, recordAt(now))
+	assert.Nil(t, err)
+
+	entry := func(id string, action domain.AuditAction, changes ...domain.FieldChange) *domain.AuditEntry {
+		return &domain.AuditEntry{ID: id, BeerID: "id01", Action: action, Actor: "subject", RequestID: "request",
+			Changes: changes, CreateTime: now}
+	}
+	deleteTime := now.UTC().Format(time.RFC3339Nano)
+	expected := []*domain.AuditEntry{
+		entry("id01", domain.AuditActionCreate, domain.FieldChange{Field: "name", After: "name"}),
+		entry("id02", domain.AuditActionUpdate, domain.FieldChange{Field: "name", Before: "name", After: name}),
+		entry("id03", domain.AuditActionDelete, domain.FieldChange{Field: "delete_time", After: deleteTime}),
+		entry("id04", domain.AuditActionUndelete, domain.FieldChange{Field: "delete_time", Before: deleteTime}),
+	}
+	actual, err := audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "id01"})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+
+	_, err = repo.ImportBeers(ctx, []*domain.Beer{{ID: "imported", Name: "name"}, {ID: "id01"}}, recordAt(now))
+	assert.Nil(t, err)
+	_, err = repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "name"}}},
+		recordAt(now))
+	assert.Nil(t, err)
+	_, err = repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "id02"}}},
+		recordAt(now))
+	assert.Nil(t, err)
+	actual, err = audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "imported"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.AuditEntry{{ID: "id05", BeerID: "imported", Action: domain.AuditActionCreate,
+		Actor: "subject", RequestID: "request", Changes: []domain.FieldChange{{Field: "name", After: "name"}},
+		CreateTime: now}}, actual)
+	actual, err = audit.ListAuditEntries(ctx, &domain.ListBeerHistoryParams{ID: "id02"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.AuditEntry{
+		{ID: "id06", BeerID: "id02", Action: domain.AuditActionCreate, Actor: "subject", RequestID: "request",
+			Changes: []domain.FieldChange{{Field: "name", After: "name"}}, CreateTime: now},
+		{ID: "id07", BeerID: "id02", Action: domain.AuditActionDelete, Actor: "subject", RequestID: "request",
+			Changes: []domain.FieldChange{{Field: "delete_time", After: deleteTime}}, CreateTime: now},
+	}, actual)
+}
+
+func TestMemoryBeerRepository_WhenAuditEntryCannotBeRecorded_DoesNotMutateBeer(t *testing.T) {
+	t.Parallel()
+	audit := infrastructure.NewMemoryAuditRepository(func() string { return "entry" })
+	repo := infrastructure.NewMemoryBeerRepository(newIDGenerator(), audit)
+	ctx := context.Background()
+	_, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+
+	expected := domain.NewAlreadyExistsError("audit entry 'entry' already exists")
+	name := "new name"
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name}, recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+	_, err = repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "other"}, recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+	_, err = repo.ImportBeers(ctx, []*domain.Beer{{ID: "imported", Name: "imported"}}, recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+	_, err = repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "other"}}},
+		recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+	_, err = repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "id01"}}},
+		recordAt(time.Now()))
+	assert.Equal(t, expected, err)
+
+	actual, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Beer{ID: "id01", Name: "name", Version: 1}, actual)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id02"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'id02' not found"), err)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "imported"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'imported' not found"), err)
+}
+
+func TestMemoryBeerRepository_DeleteBeer_WhenBeerDeleted_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	_, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewNotFoundError("beer 'id01' not found"), err)
+	name := "new name"
+	_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id01", Name: &name}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewNotFoundError("beer 'id01' not found"), err)
+}
+
+func TestMemoryBeerRepository_UndeleteBeer_UndeletesBeer(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	_, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	version := int64(2)
+	expected := &domain.Beer{ID: "id01", Name: "name", Version: 3}
+	actual, err := repo.UndeleteBeer(ctx, &domain.UndeleteBeerParams{ID: "id01", Version: &version}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	actual, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryBeerRepository_UndeleteBeer_WhenBeerCannotBeUndeleted_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "deleted"}, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	_, err := repo.DeleteBeer(ctx, &domain.DeleteBeerParams{ID: "id01"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	version := int64(1)
+	tests := []struct {
+		name   string
+		params *domain.UndeleteBeerParams
+		err    error
+	}{
+		{
+			name:   "beer does not exist",
+			params: &domain.UndeleteBeerParams{ID: "id"},
+			err:    domain.NewNotFoundError("beer 'id' not found"),
+		},
+		{
+			name:   "beer not deleted",
+			params: &domain.UndeleteBeerParams{ID: "id02"},
+			err:    domain.NewFailedPreconditionError("beer 'id02' is not deleted"),
+		},
+		{
+			name:   "version does not match",
+			params: &domain.UndeleteBeerParams{ID: "id01", Version: &version},
+			err:    domain.NewConflictError("beer 'id01' has been modified"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			_, err := repo.UndeleteBeer(ctx, test.params, recordAt(time.Now()))
+			assert.Equal(s, test.err, err)
+		})
+	}
+}
+
+func TestMemoryBeerRepository_PurgeBeers_RemovesBeersDeletedBeforeTime(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t,
+		&domain.CreateBeerParams{Name: "deleted long ago"},
+		&domain.CreateBeerParams{Name: "deleted recently"},
+		&domain.CreateBeerParams{Name: "not deleted"},
+	)
+	ctx := context.Background()
+	now := time.Now()
+	deleteBeer(t, repo, "id01", now.Add(-48*time.Hour))
+	deleteBeer(t, repo, "id02", now)
+
+	purged, err := repo.PurgeBeers(ctx, now.Add(-24*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01", ShowDeleted: true})
+	assert.Equal(t, domain.NewNotFoundError("beer 'id01' not found"), err)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id02", ShowDeleted: true})
+	assert.Nil(t, err)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id03"})
+	assert.Nil(t, err)
+}
+
+func TestMemoryBeerRepository_DeleteBeer_WhenBeerDoesNotExist_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	_, err := repo.DeleteBeer(context.Background(), &domain.DeleteBeerParams{ID: "id"}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewNotFoundError("beer 'id' not found"), err)
+}
+
+func TestMemoryBeerRepository_CreateBeer_WhenIDAlreadyExists_ReturnsAlreadyExistsError(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryBeerRepository(func() string { return "id" }, infrastructure.NewMemoryAuditRepository(newIDGenerator()))
+	ctx := context.Background()
+	_, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name"}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	_, err = repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name"}, recordAt(time.Now()))
+	assert.Equal(t, domain.NewAlreadyExistsError("beer 'id' already exists"), err)
+}
+
+func TestMemoryBeerRepository_BatchGetBeers_ReturnsBeersInOrder(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+	actual, err := repo.BatchGetBeers(context.Background(), &domain.BatchGetBeersParams{IDs: []string{"id02", "id01"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{
+		{ID: "id02", Name: "b", Version: 1},
+		{ID: "id01", Name: "a", Version: 1},
+	}, actual)
+}
+
+func TestMemoryBeerRepository_BatchGetBeers_WhenBeerDoesNotExist_ReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+	ctx := context.Background()
+	deleteBeer(t, repo, "id02", time.Now())
+	for _, id := range []string{"id02", "id03"} {
+		_, err := repo.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: []string{"id01", id}})
+		assert.Equal(t, domain.NewNotFoundError(fmt.Sprintf("beer '%s' not found", id)), err)
+	}
+}
+
+func TestMemoryBeerRepository_BatchCreateBeers_ReturnsCreatedBeers(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	ctx := context.Background()
+	expected := []*domain.Beer{
+		{ID: "id01", Name: "a", Type: domain.Ale, Version: 1},
+		{ID: "id02", Name: "b", Brewer: "brewer", Country: "country", Version: 1},
+	}
+	actual, err := repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{
+		{Name: "a", Type: domain.Ale},
+		{Name: "b", Brewer: "brewer", Country: "country"},
+	}}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	actual, err = repo.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: []string{"id01", "id02"}})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestMemoryBeerRepository_BatchCreateBeers_WhenIDAlreadyExists_CreatesNoBeers(t *testing.T) {
+	t.Parallel()
+	repo := infrastructure.NewMemoryBeerRepository(func() string { return "id" }, infrastructure.NewMemoryAuditRepository(newIDGenerator()))
+	ctx := context.Background()
+	_, err := repo.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}, {Name: "b"}}},
+		recordAt(time.Now()))
+	assert.Equal(t, domain.NewAlreadyExistsError("a beer in the batch already exists"), err)
+	_, err = repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id"})
+	assert.Equal(t, domain.NewNotFoundError("beer 'id' not found"), err)
+}
+
+func TestMemoryBeerRepository_BatchDeleteBeers_DeletesBeers(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+	ctx := context.Background()
+	version := int64(1)
+	deleteTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleted, err := repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{
+		{ID: "id02"},
+		{ID: "id01", Version: &version},
+	}}, recordAt(deleteTime))
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{
+		{ID: "id02", Name: "b", Version: 2, DeleteTime: &deleteTime},
+		{ID: "id01", Name: "a", Version: 2, DeleteTime: &deleteTime},
+	}, deleted)
+	for _, beer := range deleted {
+		actual, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: beer.ID, ShowDeleted: true})
+		assert.Nil(t, err)
+		assert.Equal(t, beer, actual)
+	}
+}
+
+func TestMemoryBeerRepository_BatchDeleteBeers_WhenBeerCannotBeDeleted_DeletesNoBeers(t *testing.T) {
+	t.Parallel()
+	version := int64(2)
+	tests := []struct {
+		name  string
+		beers []*domain.DeleteBeerParams
+		err   error
+	}{
+		{
+			name:  "beer does not exist",
+			beers: []*domain.DeleteBeerParams{{ID: "id01"}, {ID: "id03"}},
+			err:   domain.NewNotFoundError("beer 'id03' not found"),
+		},
+		{
+			name:  "version does not match",
+			beers: []*domain.DeleteBeerParams{{ID: "id01"}, {ID: "id02", Version: &version}},
+			err:   domain.NewConflictError("beer 'id02' has been modified"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			repo := newMemoryBeerRepository(s, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+			ctx := context.Background()
+			_, err := repo.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: test.beers}, recordAt(time.Now()))
+			assert.Equal(s, test.err, err)
+			actual, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+			assert.Nil(s, err)
+			assert.Equal(s, int64(1), actual.Version)
+		})
+	}
+}
+
+func TestMemoryBeerRepository_ListBeers(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t,
+		&domain.CreateBeerParams{Name: "Old Peculier", Type: domain.Ale, Brewer: "Theakston", Country: "England"},
+		&domain.CreateBeerParams{Name: "Guinness", Type: domain.Stout, Brewer: "Guinness", Country: "Ireland"},
+		&domain.CreateBeerParams{Name: "Old Speckled Hen", Type: domain.Ale, Brewer: "Greene King", Country: "England"},
+		&domain.CreateBeerParams{Name: "Pilsner Urquell", Type: domain.Pilsner, Brewer: "Plzeňský Prazdroj", Country: "Czech Republic"},
+		&domain.CreateBeerParams{Name: "Abbot Ale", Type: domain.Ale, Brewer: "Greene King", Country: "England"},
+	)
+	ale := domain.Ale
+	tests := []struct {
+		name   string
+		params *domain.ListBeersParams
+		ids    []string
+		next   *domain.Cursor
+		total  int
+	}{
+		{
+			name:   "all beers",
+			params: &domain.ListBeersParams{PageSize: 10},
+			ids:    []string{"id01", "id02", "id03", "id04", "id05"},
+			total:  5,
+		},
+		{
+			name:   "first page",
+			params: &domain.ListBeersParams{PageSize: 2},
+			ids:    []string{"id01", "id02"},
+			next:   &domain.Cursor{ID: "id02", Name: "Guinness", Type: domain.Stout, Brewer: "Guinness", Country: "Ireland"},
+			total:  5,
+		},
+		{
+			name:   "page after cursor",
+			params: &domain.ListBeersParams{PageSize: 2, After: &domain.Cursor{ID: "id02"}},
+			ids:    []string{"id03", "id04"},
+			next:   &domain.Cursor{ID: "id04", Name: "Pilsner Urquell", Type: domain.Pilsner, Brewer: "Plzeňský Prazdroj", Country: "Czech Republic"},
+			total:  5,
+		},
+		{
+			name:   "last page",
+			params: &domain.ListBeersParams{PageSize: 2, After: &domain.Cursor{ID: "id04"}},
+			ids:    []string{"id05"},
+			total:  5,
+		},
+		{
+			name:   "filtered by type",
+			params: &domain.ListBeersParams{PageSize: 10, Type: &ale},
+			ids:    []string{"id01", "id03", "id05"},
+			total:  3,
+		},
+		{
+			name:   "filtered by brewer and country",
+			params: &domain.ListBeersParams{PageSize: 10, Brewer: "Greene King", Country: "England"},
+			ids:    []string{"id03", "id05"},
+			total:  2,
+		},
+		{
+			name:   "filtered by name prefix",
+			params: &domain.ListBeersParams{PageSize: 10, NamePrefix: "Old"},
+			ids:    []string{"id01", "id03"},
+			total:  2,
+		},
+		{
+			name:   "ordered by name descending",
+			params: &domain.ListBeersParams{PageSize: 10, OrderBy: "name desc"},
+			ids:    []string{"id04", "id03", "id01", "id02", "id05"},
+			total:  5,
+		},
+		{
+			name:   "ordered by brewer then name",
+			params: &domain.ListBeersParams{PageSize: 10, OrderBy: "brewer,name"},
+			ids:    []string{"id05", "id03", "id02", "id04", "id01"},
+			total:  5,
+		},
+		{
+			name: "ordered by type descending after cursor",
+			params: &domain.ListBeersParams{
+				PageSize: 10,
+				OrderBy:  "type desc",
+				After:    &domain.Cursor{ID: "id01", Type: domain.Ale},
+			},
+			ids:   []string{"id03", "id05"},
+			total: 5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			result, err := repo.ListBeers(context.Background(), test.params)
+			assert.Nil(s, err)
+			var ids []string
+			for _, beer := range result.Beers {
+				ids = append(ids, beer.ID)
+			}
+			assert.Equal(s, test.ids, ids)
+			assert.Equal(s, test.next, result.Next)
+			assert.Equal(s, test.total, result.TotalSize)
+		})
+	}
+}
+
+func TestMemoryBeerRepository_ListBeers_WhenBeersDeleted_ListsDeletedBeersOnlyIfShown(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "deleted"}, &domain.CreateBeerParams{Name: "name"})
+	ctx := context.Background()
+	deleteBeer(t, repo, "id01", time.Now())
+
+	result, err := repo.ListBeers(ctx, &domain.ListBeersParams{PageSize: 10})
+	assert.Nil(t, err)
+	assert.Len(t, result.Beers, 1)
+	assert.Equal(t, "id02", result.Beers[0].ID)
+	assert.Equal(t, 1, result.TotalSize)
+
+	result, err = repo.ListBeers(ctx, &domain.ListBeersParams{PageSize: 10, ShowDeleted: true})
+	assert.Nil(t, err)
+	assert.Len(t, result.Beers, 2)
+	assert.Equal(t, 2, result.TotalSize)
+}
+
+func TestMemoryBeerRepository_SearchBeers(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t,
+		&domain.CreateBeerParams{Name: "Westmalle Tripel", Type: domain.Ale, Brewer: "Westmalle", Country: "Belgium"},
+		&domain.CreateBeerParams{Name: "Tripel Karmeliet", Type: domain.Ale, Brewer: "Bosteels", Country: "Belgium"},
+		&domain.CreateBeerParams{Name: "Orval", Type: domain.Ale, Brewer: "Orval", Country: "Belgium"},
+		&domain.CreateBeerParams{Name: "Chimay Blue", Type: domain.Ale, Brewer: "Chimay", Country: "Belgium"},
+		&domain.CreateBeerParams{Name: "Westmalle Dubbel", Type: domain.Ale, Brewer: "Westmalle", Country: "Belgium"},
+	)
+	ctx := context.Background()
+	deleteBeer(t, repo, "id05", time.Now())
+
+	tests := []struct {
+		name   string
+		params *domain.SearchBeersParams
+		ids    []string
+		next   *domain.Cursor
+	}{
+		{
+			name:   "name",
+			params: &domain.SearchBeersParams{Query: "Westmalle", PageSize: 10},
+			ids:    []string{"id01"},
+		},
+		{
+			name:   "equal scores ordered by id",
+			params: &domain.SearchBeersParams{Query: "tripel", PageSize: 10},
+			ids:    []string{"id01", "id02"},
+		},
+		{
+			name:   "name ranked above brewer",
+			params: &domain.SearchBeersParams{Query: "tripel bosteels", PageSize: 10},
+			ids:    []string{"id02", "id01"},
+		},
+		{
+			name:   "partial word",
+			params: &domain.SearchBeersParams{Query: "karm", PageSize: 10},
+			ids:    []string{"id02"},
+		},
+		{
+			name:   "misspelled word",
+			params: &domain.SearchBeersParams{Query: "chimey", PageSize: 10},
+			ids:    []string{"id04"},
+		},
+		{
+			name:   "first page",
+			params: &domain.SearchBeersParams{Query: "belgium", PageSize: 2},
+			ids:    []string{"id01", "id02"},
+			next:   &domain.Cursor{ID: "id02", Score: 0.2},
+		},
+		{
+			name:   "page after cursor",
+			params: &domain.SearchBeersParams{Query: "belgium", PageSize: 2, After: &domain.Cursor{ID: "id02", Score: 0.2}},
+			ids:    []string{"id03", "id04"},
+		},
+		{
+			name:   "no match",
+			params: &domain.SearchBeersParams{Query: "stout", PageSize: 10},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			result, err := repo.SearchBeers(ctx, test.params)
+			assert.Nil(s, err)
+			var ids []string
+			for _, r := range result.Results {
+				ids = append(ids, r.Beer.ID)
+			}
+			assert.Equal(s, test.ids, ids)
+			assert.Equal(s, test.next, result.Next)
+		})
+	}
+}
+
+func TestMemoryBeerRepository_ExportBeers_ExportsBeersInIDOrder(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t,
+		&domain.CreateBeerParams{Name: "a"},
+		&domain.CreateBeerParams{Name: "b"},
+		&domain.CreateBeerParams{Name: "c"},
+	)
+	ctx := context.Background()
+	deleteTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleteBeer(t, repo, "id02", deleteTime)
+
+	tests := []struct {
+		name     string
+		params   *domain.ExportBeersParams
+		expected []string
+	}{
+		{
+			name:     "without deleted beers",
+			params:   &domain.ExportBeersParams{},
+			expected: []string{"id01", "id03"},
+		},
+		{
+			name:     "with deleted beers",
+			params:   &domain.ExportBeersParams{ShowDeleted: true},
+			expected: []string{"id01", "id02", "id03"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			var ids []string
+			err := repo.ExportBeers(ctx, test.params, func(beer *domain.Beer) error {
+				ids = append(ids, beer.ID)
+				return nil
+			})
+			assert.Nil(s, err)
+			assert.Equal(s, test.expected, ids)
+		})
+	}
+}
+
+func TestMemoryBeerRepository_ExportBeers_WhenHandlerReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+	expected := errors.New("something went wrong")
+	calls := 0
+	err := repo.ExportBeers(context.Background(), &domain.ExportBeersParams{}, func(beer *domain.Beer) error {
+		calls++
+		return expected
+	})
+	assert.Equal(t, expected, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMemoryBeerRepository_ExportBeers_IsSnapshot(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "a"}, &domain.CreateBeerParams{Name: "b"})
+	ctx := context.Background()
+	var names []string
+	err := repo.ExportBeers(ctx, &domain.ExportBeersParams{}, func(beer *domain.Beer) error {
+		names = append(names, beer.Name)
+		name := "changed"
+		_, err := repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: "id02", Name: &name}, recordAt(time.Now()))
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+}
+
+func TestMemoryBeerRepository_ImportBeers_CreatesBeersWhichDoNotExist(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t, &domain.CreateBeerParams{Name: "existing"})
+	ctx := context.Background()
+	deleteTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	created, err := repo.ImportBeers(ctx, []*domain.Beer{
+		{ID: "a", Name: "a", Type: domain.Ale, Version: 5},
+		{ID: "id01", Name: "skipped"},
+		{Name: "generated"},
+		{ID: "deleted", Name: "deleted", DeleteTime: &deleteTime},
+		{ID: "a", Name: "duplicate"},
+	}, recordAt(time.Now()))
+	assert.Nil(t, err)
+	assert.Equal(t, []*domain.Beer{
+		{ID: "a", Name: "a", Type: domain.Ale, Version: 1},
+		{ID: "id02", Name: "generated", Version: 1},
+		{ID: "deleted", Name: "deleted", Version: 1, DeleteTime: &deleteTime},
+	}, created)
+
+	existing, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: "id01"})
+	assert.Nil(t, err)
+	assert.Equal(t, "existing", existing.Name)
+	for _, beer := range created {
+		actual, err := repo.GetBeer(ctx, &domain.GetBeerParams{ID: beer.ID, ShowDeleted: true})
+		assert.Nil(t, err)
+		assert.Equal(t, beer, actual)
+	}
+}
+
+func TestMemoryBeerRepository_IsSafeForConcurrentUse(t *testing.T) {
+	t.Parallel()
+	repo := newMemoryBeerRepository(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			beer, err := repo.CreateBeer(ctx, &domain.CreateBeerParams{Name: "name"}, recordAt(time.Now()))
+			assert.Nil(t, err)
+			name := "new name"
+			_, err = repo.UpdateBeer(ctx, &domain.UpdateBeerParams{ID: beer.ID, Name: &name}, recordAt(time.Now()))
+			assert.Nil(t, err)
+			_, err = repo.ListBeers(ctx, &domain.ListBeersParams{PageSize: 5})
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	result, err := repo.ListBeers(ctx, &domain.ListBeersParams{PageSize: 100})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, result.TotalSize)
+}
@@ -0,0 +1,367 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Migration is a versioned change of the postgres schema.
+type Migration struct {
+	Version     int
+	Description string
+	// Up are the statements applying the migration.
+	Up string
+	// Down are the statements reverting the migration.
+	Down string
+}
+
+// Checksum returns the checksum of the up statements of the migration, which
+// is recorded when the migration is applied so that changes to applied
+// migrations are detected.
+func (m *Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up))
+	return hex.EncodeToString(sum[:])
+}
+
+// migrations are the migrations of the beers database in version order. Once
+// released a migration must not be changed, the schema is changed by adding
+// a migration. The first migrations create the tables only if they do not
+// exist so that databases created before migrations can be migrated.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create beers",
+		Up: `
+		CREATE TABLE IF NOT EXISTS BEERS (
+			id VARCHAR(36) PRIMARY KEY,
+			name TEXT,
+			type INT,
+			brewer TEXT,
+			country TEXT
+		);`,
+		Down: `DROP TABLE BEERS;`,
+	},
+	{
+		Version:     2,
+		Description: "add beer version",
+		Up:          `ALTER TABLE BEERS ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;`,
+		Down:        `ALTER TABLE BEERS DROP COLUMN version;`,
+	},
+	{
+		Version:     3,
+		Description: "create api keys",
+		Up: `
+		CREATE TABLE IF NOT EXISTS API_KEYS (
+			id VARCHAR(36) PRIMARY KEY,
+			name TEXT NOT NULL,
+			hash BYTEA NOT NULL UNIQUE,
+			scopes TEXT[] NOT NULL,
+			create_time TIMESTAMPTZ NOT NULL,
+			expire_time TIMESTAMPTZ NOT NULL,
+			revoke_time TIMESTAMPTZ
+		);`,
+		Down: `DROP TABLE API_KEYS;`,
+	},
+	{
+		Version:     4,
+		Description: "add beer delete time",
+		Up: `
+		ALTER TABLE BEERS ADD COLUMN deleted_at TIMESTAMPTZ;
+		CREATE INDEX BEERS_DELETED_AT ON BEERS (deleted_at) WHERE deleted_at IS NOT NULL;`,
+		Down: `ALTER TABLE BEERS DROP COLUMN deleted_at;`,
+	},
+	{
+		Version:     5,
+		Description: "create beer audit",
+		Up: `
+		CREATE TABLE BEER_AUDIT (
+			id VARCHAR(36) PRIMARY KEY,
+			seq BIGSERIAL NOT NULL,
+			beer_id VARCHAR(36) NOT NULL,
+			action TEXT NOT NULL,
+			actor TEXT NOT NULL,
+			request_id TEXT NOT NULL,
+			changes JSONB NOT NULL,
+			create_time TIMESTAMPTZ NOT NULL
+		);
+		CREATE INDEX BEER_AUDIT_BEER_ID ON BEER_AUDIT (beer_id, seq);`,
+		Down: `DROP TABLE BEER_AUDIT;`,
+	},
+	{
+		Version:     6,
+		Description: "add beer search",
+		Up: `
+		CREATE EXTENSION IF NOT EXISTS pg_trgm;
+		ALTER TABLE BEERS
+			ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+				setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+				setweight(to_tsvector('simple', coalesce(brewer, '')), 'B') ||
+				setweight(to_tsvector('simple', coalesce(country, '')), 'C')) STORED,
+			ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+				coalesce(name, '') || ' ' || coalesce(brewer, '') || ' ' || coalesce(country, '')) STORED;
+		CREATE INDEX BEERS_SEARCH_VECTOR ON BEERS USING GIN (search_vector);
+		CREATE INDEX BEERS_SEARCH_TEXT ON BEERS USING GIN (search_text gin_trgm_ops);`,
+		// The pg_trgm extension is kept as other schemas may use it.
+		Down: `ALTER TABLE BEERS DROP COLUMN search_text, DROP COLUMN search_vector;`,
+	},
+	{
+		Version:     7,
+		Description: "add beer attributes",
+		Up: `
+		ALTER TABLE BEERS
+			ADD COLUMN abv DOUBLE PRECISION NOT NULL DEFAULT 0,
+			ADD COLUMN ibu INT NOT NULL DEFAULT 0,
+			ADD COLUMN srm DOUBLE PRECISION NOT NULL DEFAULT 0,
+			ADD COLUMN volume_ml INT NOT NULL DEFAULT 0,
+			ADD COLUMN description TEXT NOT NULL DEFAULT '';`,
+		Down: `ALTER TABLE BEERS DROP COLUMN abv, DROP COLUMN ibu, DROP COLUMN srm, DROP COLUMN volume_ml, DROP COLUMN description;`,
+	},
+}
+
+// migrationsLock is the key of the postgres advisory lock held while
+// migrating, so that only one migration runs at a time.
+const migrationsLock = 7262337
+
+// AppliedMigration is a migration recorded as applied in the database.
+type AppliedMigration struct {
+	Version     int
+	Description string
+	Checksum    string
+	AppliedTime time.Time
+}
+
+// MigrationStatus is the status of a migration.
+type MigrationStatus struct {
+	Version     int
+	Description string
+	// AppliedTime is the time the migration was applied, nil if pending.
+	AppliedTime *time.Time
+}
+
+// NewMigrator creates a new migrator of the postgres database using the
+// connection pool to the database.
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	return newMigrator(newTracedDB(db), migrations)
+}
+
+func newMigrator(db *tracedDB, migrations []Migration) (*Migrator, error) {
+	if err := validateMigrations(migrations); err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Migrator migrates the schema of the postgres database. Applied migrations
+// are recorded in the SCHEMA_MIGRATIONS table.
+type Migrator struct {
+	db         *tracedDB
+	migrations []Migration
+}
+
+// Up applies the pending migrations in version order and returns them. The
+// migrations are applied in a single transaction, so either all of them are
+// applied or none are.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var pending []Migration
+	err := m.migrate(ctx, func(tx *tracedTx, applied []AppliedMigration) error {
+		if err := checkDrift(m.migrations, applied); err != nil {
+			return err
+		}
+		pending = m.migrations[len(applied):]
+		for _, migration := range pending {
+			if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+				return fmt.Errorf("error applying migration %d '%s': %w", migration.Version, migration.Description, err)
+			}
+			_, err := tx.ExecContext(ctx, `
+			INSERT INTO SCHEMA_MIGRATIONS (version, description, checksum, applied_time)
+			VALUES ($1, $2, $3, $4);`, migration.Version, migration.Description, migration.Checksum(), time.Now())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// Down reverts up to the given number of the last applied migrations in
+// reverse version order and returns them. The migrations are reverted in a
+// single transaction.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("invalid steps %d", steps)
+	}
+	var reverted []Migration
+	err := m.migrate(ctx, func(tx *tracedTx, applied []AppliedMigration) error {
+		if err := checkDrift(m.migrations, applied); err != nil {
+			return err
+		}
+		for i := len(applied) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+				return fmt.Errorf("error reverting migration %d '%s': %w", migration.Version, migration.Description, err)
+			}
+			_, err := tx.ExecContext(ctx, "DELETE FROM SCHEMA_MIGRATIONS WHERE version = $1;", migration.Version)
+			if err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reverted, nil
+}
+
+// Status returns the status of the migrations, and of applied migrations
+// which are unknown to the migrator.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.migrate(ctx, func(tx *tracedTx, applied []AppliedMigration) error {
+		statuses = migrationStatuses(m.migrations, applied)
+		return nil
+	})
+	return statuses, err
+}
+
+// Check returns an error describing the drift between the migrations applied
+// to the database and the migrations, if any.
+func (m *Migrator) Check(ctx context.Context) error {
+	return m.migrate(ctx, func(tx *tracedTx, applied []AppliedMigration) error {
+		return checkDrift(m.migrations, applied)
+	})
+}
+
+// migrate calls the function with the applied migrations in a transaction
+// holding the migrations lock. The transaction is committed if the function
+// returns nil, and rolled back otherwise.
+func (m *Migrator) migrate(ctx context.Context, f func(tx *tracedTx, applied []AppliedMigration) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return queryError(ctx, err)
+	}
+	defer func() {
+		// Rolling back a committed transaction does nothing.
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1);", migrationsLock); err != nil {
+		return queryError(ctx, err)
+	}
+	_, err = tx.ExecContext(ctx, `
+	CREATE TABLE IF NOT EXISTS SCHEMA_MIGRATIONS (
+		version INT PRIMARY KEY,
+		description TEXT NOT NULL,
+		checksum TEXT NOT NULL,
+		applied_time TIMESTAMPTZ NOT NULL
+	);`)
+	if err != nil {
+		return queryError(ctx, err)
+	}
+	applied, err := appliedMigrations(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if err := f(tx, applied); err != nil {
+		return queryError(ctx, err)
+	}
+	return tx.Commit()
+}
+
+// appliedMigrations returns the applied migrations in version order.
+func appliedMigrations(ctx context.Context, tx *tracedTx) ([]AppliedMigration, error) {
+	rows, err := tx.QueryContext(ctx, `
+	SELECT version, description, checksum, applied_time
+	FROM SCHEMA_MIGRATIONS
+	ORDER BY version;`)
+	if err != nil {
+		return nil, queryError(ctx, err)
+	}
+	defer rows.Close()
+
+	var applied []AppliedMigration
+	for rows.Next() {
+		var a AppliedMigration
+		if err := rows.Scan(&a.Version, &a.Description, &a.Checksum, &a.AppliedTime); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+
+	// Check for any errors encountered.
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, err)
+	}
+	return applied, nil
+}
+
+// validateMigrations checks the migrations are numbered consecutively from 1
+// and have up and down statements.
+func validateMigrations(migrations []Migration) error {
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return fmt.Errorf("migration %d '%s' should be version %d", migration.Version, migration.Description, i+1)
+		}
+		if strings.TrimSpace(migration.Up) == "" || strings.TrimSpace(migration.Down) == "" {
+			return fmt.Errorf("migration %d '%s' has no up or down statements", migration.Version, migration.Description)
+		}
+	}
+	return nil
+}
+
+// checkDrift returns an error describing the drift between the applied
+// migrations and the migrations, if any. The database has drifted if a
+// migration unknown to the migrations has been applied, an applied migration
+// has changed since it was applied or a migration has been skipped.
+func checkDrift(migrations []Migration, applied []AppliedMigration) error {
+	var drift []string
+	for i, a := range applied {
+		switch {
+		case a.Version > len(migrations):
+			drift = append(drift, fmt.Sprintf("unknown migration %d '%s' has been applied", a.Version, a.Description))
+		case a.Version != i+1:
+			drift = append(drift, fmt.Sprintf("migration %d '%s' has not been applied", i+1, migrations[i].Description))
+		case a.Checksum != migrations[i].Checksum():
+			drift = append(drift, fmt.Sprintf("migration %d '%s' has changed since it was applied", a.Version, a.Description))
+		}
+	}
+	if len(drift) > 0 {
+		return errors.New("schema has drifted from migrations: " + strings.Join(drift, ", "))
+	}
+	return nil
+}
+
+// migrationStatuses returns the status of the migrations, followed by the
+// applied migrations which are unknown to the migrations.
+func migrationStatuses(migrations []Migration, applied []AppliedMigration) []MigrationStatus {
+	appliedTimes := make(map[int]time.Time, len(applied))
+	for _, a := range applied {
+		appliedTimes[a.Version] = a.AppliedTime
+	}
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, migration := range migrations {
+		status := MigrationStatus{Version: migration.Version, Description: migration.Description}
+		if appliedTime, ok := appliedTimes[migration.Version]; ok {
+			status.AppliedTime = &appliedTime
+		}
+		statuses = append(statuses, status)
+	}
+	for _, a := range applied {
+		if a.Version > len(migrations) {
+			appliedTime := a.AppliedTime
+			statuses = append(statuses, MigrationStatus{Version: a.Version, Description: a.Description, AppliedTime: &appliedTime})
+		}
+	}
+	return statuses
+}
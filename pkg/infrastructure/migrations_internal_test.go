@@ -0,0 +1,130 @@
+package infrastructure
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrations_AreValid(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, validateMigrations(migrations))
+}
+
+func TestValidateMigrations_WhenInvalid_ReturnsError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		migrations []Migration
+		err        string
+	}{
+		{
+			name:       "not starting at 1",
+			migrations: []Migration{{Version: 2, Description: "two", Up: "up", Down: "down"}},
+			err:        "migration 2 'two' should be version 1",
+		},
+		{
+			name: "not consecutive",
+			migrations: []Migration{
+				{Version: 1, Description: "one", Up: "up", Down: "down"},
+				{Version: 3, Description: "three", Up: "up", Down: "down"},
+			},
+			err: "migration 3 'three' should be version 2",
+		},
+		{
+			name:       "no down statements",
+			migrations: []Migration{{Version: 1, Description: "one", Up: "up", Down: " "}},
+			err:        "migration 1 'one' has no up or down statements",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			assert.EqualError(s, validateMigrations(test.migrations), test.err)
+		})
+	}
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Description: "one", Up: "up 1", Down: "down 1"},
+		{Version: 2, Description: "two", Up: "up 2", Down: "down 2"},
+		{Version: 3, Description: "three", Up: "up 3", Down: "down 3"},
+	}
+}
+
+func applied(migrations ...Migration) []AppliedMigration {
+	var applied []AppliedMigration
+	for _, m := range migrations {
+		applied = append(applied, AppliedMigration{Version: m.Version, Description: m.Description, Checksum: m.Checksum()})
+	}
+	return applied
+}
+
+func TestCheckDrift(t *testing.T) {
+	t.Parallel()
+	m := testMigrations()
+	changed := m[1]
+	changed.Up = "changed"
+	tests := []struct {
+		name    string
+		applied []AppliedMigration
+		err     string
+	}{
+		{
+			name: "none applied",
+		},
+		{
+			name:    "some applied",
+			applied: applied(m[0], m[1]),
+		},
+		{
+			name:    "all applied",
+			applied: applied(m...),
+		},
+		{
+			name:    "unknown migration applied",
+			applied: applied(append(m, Migration{Version: 4, Description: "four", Up: "up 4"})...),
+			err:     "schema has drifted from migrations: unknown migration 4 'four' has been applied",
+		},
+		{
+			name:    "migration skipped",
+			applied: applied(m[0], m[2]),
+			err:     "schema has drifted from migrations: migration 2 'two' has not been applied",
+		},
+		{
+			name:    "migration changed",
+			applied: applied(m[0], changed),
+			err:     "schema has drifted from migrations: migration 2 'two' has changed since it was applied",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			err := checkDrift(m, test.applied)
+			if test.err == "" {
+				assert.Nil(s, err)
+			} else {
+				assert.EqualError(s, err, test.err)
+			}
+		})
+	}
+}
+
+func TestMigrationStatuses(t *testing.T) {
+	t.Parallel()
+	m := testMigrations()
+	appliedTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	a := applied(m[0], Migration{Version: 4, Description: "four"})
+	a[0].AppliedTime = appliedTime
+	a[1].AppliedTime = appliedTime
+
+	assert.Equal(t, []MigrationStatus{
+		{Version: 1, Description: "one", AppliedTime: &appliedTime},
+		{Version: 2, Description: "two"},
+		{Version: 3, Description: "three"},
+		{Version: 4, Description: "four", AppliedTime: &appliedTime},
+	}, migrationStatuses(m, a))
+}
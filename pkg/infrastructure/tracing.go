@@ -0,0 +1,263 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/standard"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/codes"
+)
+
+// TracingSettings describes the settings for exporting traces.
+type TracingSettings struct {
+	// ServiceName is the name of the service spans are recorded for.
+	ServiceName string
+	// Exporter is the exporter spans are exported with, either 'none',
+	// 'stdout' or 'otlp'.
+	Exporter string
+	// OTLPEndpoint is the address of the collector spans are exported to
+	// with the 'otlp' exporter.
+	OTLPEndpoint string
+	// SampleRatio is the ratio of traces started by the service which are
+	// sampled. Traces started by callers are sampled if the caller sampled
+	// them.
+	SampleRatio float64
+	// Writer is the writer spans are written to with the 'stdout' exporter,
+	// defaulting to os.Stdout.
+	Writer io.Writer
+}
+
+// NewTraceProvider creates a new trace provider which exports spans with the
+// exporter in the settings.
+func NewTraceProvider(settings *TracingSettings) (*TraceProvider, error) {
+	provider, err := sdktrace.NewProvider(
+		sdktrace.WithConfig(sdktrace.Config{
+			DefaultSampler: sdktrace.ParentSample(sdktrace.ProbabilitySampler(settings.SampleRatio)),
+		}),
+		sdktrace.WithResource(resource.New(standard.ServiceNameKey.String(settings.ServiceName))),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, stop, err := newSpanExporter(settings)
+	if err != nil {
+		return nil, err
+	}
+	tp := &TraceProvider{Provider: provider, stop: stop}
+	if exporter != nil {
+		tp.processor, err = sdktrace.NewBatchSpanProcessor(exporter)
+		if err != nil {
+			return nil, err
+		}
+		provider.RegisterSpanProcessor(tp.processor)
+	}
+	return tp, nil
+}
+
+// newSpanExporter creates the span exporter in the settings, returning a nil
+// exporter if spans are not exported and a function to stop the exporter.
+func newSpanExporter(settings *TracingSettings) (export.SpanBatcher, func() error, error) {
+	noop := func() error { return nil }
+	switch settings.Exporter {
+	case "none":
+		return nil, noop, nil
+	case "stdout":
+		w := settings.Writer
+		if w == nil {
+			w = os.Stdout
+		}
+		exporter, err := stdout.NewExporter(stdout.WithWriter(w), stdout.WithoutMetricExport())
+		if err != nil {
+			return nil, nil, err
+		}
+		return exporter, noop, nil
+	case "otlp":
+		exporter, err := otlp.NewExporter(otlp.WithInsecure(), otlp.WithAddress(settings.OTLPEndpoint))
+		if err != nil {
+			return nil, nil, err
+		}
+		return exporter, exporter.Stop, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown trace exporter '%s'", settings.Exporter)
+	}
+}
+
+// TraceProvider is a trace provider which exports spans.
+type TraceProvider struct {
+	*sdktrace.Provider
+	processor *sdktrace.BatchSpanProcessor
+	stop      func() error
+}
+
+// Close exports any spans which have not yet been exported and stops the
+// exporter.
+func (tp *TraceProvider) Close() error {
+	if tp.processor != nil {
+		// Unregistering the processor shuts it down, flushing its queue.
+		tp.UnregisterSpanProcessor(tp.processor)
+	}
+	return tp.stop()
+}
+
+// tracedDB is a database which traces the SQL statements executed with
+// QueryRowContext, QueryContext and ExecContext, and the transactions begun
+// with BeginTx.
+type tracedDB struct {
+	*sql.DB
+	tracer trace.Tracer
+}
+
+func newTracedDB(db *sql.DB) *tracedDB {
+	return &tracedDB{
+		DB:     db,
+		tracer: global.Tracer("github.com/bvwells/grpc-gateway-example/pkg/infrastructure"),
+	}
+}
+
+// startStatement starts a span for the SQL statement.
+func startStatement(ctx context.Context, tracer trace.Tracer, query string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "postgres",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(standard.DBSystemPostgres, standard.DBStatementKey.String(query)),
+	)
+}
+
+// endSpan ends the span, recording the error if the statement failed.
+func endSpan(ctx context.Context, span trace.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(ctx, err, trace.WithErrorStatus(codes.Unknown))
+	}
+	span.End()
+}
+
+// QueryRowContext executes a query which returns at most one row. The span of
+// the query ends when the row is scanned, so the row must be scanned.
+func (db *tracedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *tracedRow {
+	ctx, span := startStatement(ctx, db.tracer, query)
+	return &tracedRow{Row: db.DB.QueryRowContext(ctx, query, args...), ctx: ctx, span: span}
+}
+
+// QueryContext executes a query which returns rows.
+func (db *tracedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startStatement(ctx, db.tracer, query)
+	rows, err := db.DB.QueryContext(ctx, query, args...)
+	endSpan(ctx, span, err)
+	return rows, err
+}
+
+// ExecContext executes a statement which does not return rows.
+func (db *tracedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startStatement(ctx, db.tracer, query)
+	result, err := db.DB.ExecContext(ctx, query, args...)
+	endSpan(ctx, span, err)
+	return result, err
+}
+
+// BeginTx begins a transaction, traced by a span which ends when the
+// transaction is committed or rolled back. The statements executed in the
+// transaction are traced by children of the span.
+func (db *tracedDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*tracedTx, error) {
+	ctx, span := db.tracer.Start(ctx, "postgres transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(standard.DBSystemPostgres),
+	)
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		endSpan(ctx, span, err)
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, tracer: db.tracer, ctx: ctx, span: span}, nil
+}
+
+// tracedRow is a row whose query is traced until the row is scanned.
+type tracedRow struct {
+	*sql.Row
+	ctx  context.Context
+	span trace.Span
+}
+
+// Scan scans the row and ends the span of its query, recording the error if
+// the query or scan failed.
+func (r *tracedRow) Scan(dest ...interface{}) error {
+	err := r.Row.Scan(dest...)
+	endSpan(r.ctx, r.span, err)
+	return err
+}
+
+// tracedTx is a transaction which traces the SQL statements executed with
+// QueryRowContext, QueryContext and ExecContext.
+type tracedTx struct {
+	*sql.Tx
+	tracer trace.Tracer
+	// ctx is the context of the span of the transaction.
+	ctx  context.Context
+	span trace.Span
+	done bool
+}
+
+// start starts a span for the SQL statement as a child of the span of the
+// transaction.
+func (tx *tracedTx) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	return startStatement(trace.ContextWithSpan(ctx, tx.span), tx.tracer, query)
+}
+
+// QueryRowContext executes a query which returns at most one row. The span of
+// the query ends when the row is scanned, so the row must be scanned.
+func (tx *tracedTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *tracedRow {
+	ctx, span := tx.start(ctx, query)
+	return &tracedRow{Row: tx.Tx.QueryRowContext(ctx, query, args...), ctx: ctx, span: span}
+}
+
+// QueryContext executes a query which returns rows.
+func (tx *tracedTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := tx.start(ctx, query)
+	rows, err := tx.Tx.QueryContext(ctx, query, args...)
+	endSpan(ctx, span, err)
+	return rows, err
+}
+
+// ExecContext executes a statement which does not return rows.
+func (tx *tracedTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := tx.start(ctx, query)
+	result, err := tx.Tx.ExecContext(ctx, query, args...)
+	endSpan(ctx, span, err)
+	return result, err
+}
+
+// Commit commits the transaction and ends its span.
+func (tx *tracedTx) Commit() error {
+	err := tx.Tx.Commit()
+	tx.end(err)
+	return err
+}
+
+// Rollback rolls back the transaction and ends its span. Rolling back a
+// transaction which is already committed or rolled back does nothing.
+func (tx *tracedTx) Rollback() error {
+	err := tx.Tx.Rollback()
+	if err == sql.ErrTxDone {
+		return err
+	}
+	tx.end(err)
+	return err
+}
+
+// end ends the span of the transaction the first time it is called.
+func (tx *tracedTx) end(err error) {
+	if tx.done {
+		return
+	}
+	tx.done = true
+	endSpan(tx.ctx, tx.span, err)
+}
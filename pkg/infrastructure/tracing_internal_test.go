@@ -0,0 +1,157 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeDriver is a database driver whose queries return a single row holding
+// the string "value", except for the query "fail", which fails.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query: query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+var errFakeQuery = errors.New("query failed")
+
+type fakeStmt struct{ query string }
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query == "fail" {
+		return nil, errFakeQuery
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query == "fail" {
+		return nil, errFakeQuery
+	}
+	return &fakeRows{}, nil
+}
+
+type fakeRows struct{ done bool }
+
+func (*fakeRows) Columns() []string { return []string{"value"} }
+func (*fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = "value"
+	return nil
+}
+
+func init() {
+	sql.Register("fake", fakeDriver{})
+}
+
+// newFakeTracedDB returns a traced fake database and the recorder of its
+// spans.
+func newFakeTracedDB(t *testing.T) (*tracedDB, *testtrace.StandardSpanRecorder) {
+	db, err := sql.Open("fake", "")
+	require.Nil(t, err)
+	t.Cleanup(func() { db.Close() })
+	recorder := &testtrace.StandardSpanRecorder{}
+	tracer := testtrace.NewProvider(testtrace.WithSpanRecorder(recorder)).Tracer("test")
+	return &tracedDB{DB: db, tracer: tracer}, recorder
+}
+
+func TestTracedDB_QueryRowContext_EndsSpanWhenRowScanned(t *testing.T) {
+	t.Parallel()
+	db, recorder := newFakeTracedDB(t)
+
+	row := db.QueryRowContext(context.Background(), "SELECT value")
+	assert.Len(t, recorder.Completed(), 0)
+
+	var value string
+	require.Nil(t, row.Scan(&value))
+	assert.Equal(t, "value", value)
+	require.Len(t, recorder.Completed(), 1)
+	assert.Equal(t, codes.OK, recorder.Completed()[0].StatusCode())
+}
+
+func TestTracedDB_QueryRowContext_WhenScanFails_RecordsError(t *testing.T) {
+	t.Parallel()
+	db, recorder := newFakeTracedDB(t)
+
+	var value int
+	err := db.QueryRowContext(context.Background(), "SELECT value").Scan(&value)
+	assert.NotNil(t, err)
+	require.Len(t, recorder.Completed(), 1)
+	assert.Equal(t, codes.Unknown, recorder.Completed()[0].StatusCode())
+}
+
+func TestTracedDB_QueryRowContext_WhenQueryFails_RecordsError(t *testing.T) {
+	t.Parallel()
+	db, recorder := newFakeTracedDB(t)
+
+	var value string
+	err := db.QueryRowContext(context.Background(), "fail").Scan(&value)
+	assert.Equal(t, errFakeQuery, err)
+	require.Len(t, recorder.Completed(), 1)
+	assert.Equal(t, codes.Unknown, recorder.Completed()[0].StatusCode())
+}
+
+func TestTracedDB_BeginTx_TracesStatementsAsChildrenOfTransaction(t *testing.T) {
+	t.Parallel()
+	db, recorder := newFakeTracedDB(t)
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.Nil(t, err)
+	_, err = tx.ExecContext(ctx, "UPDATE")
+	require.Nil(t, err)
+	_, err = tx.ExecContext(ctx, "fail")
+	assert.Equal(t, errFakeQuery, err)
+	var value string
+	require.Nil(t, tx.QueryRowContext(ctx, "SELECT value").Scan(&value))
+	require.Nil(t, tx.Commit())
+	assert.Equal(t, sql.ErrTxDone, tx.Rollback())
+
+	spans := recorder.Completed()
+	require.Len(t, spans, 4)
+	transaction := spans[3]
+	assert.Equal(t, "postgres transaction", transaction.Name())
+	assert.Equal(t, codes.OK, transaction.StatusCode())
+	for _, span := range spans[:3] {
+		assert.Equal(t, "postgres", span.Name())
+		assert.Equal(t, transaction.SpanContext().SpanID, span.ParentSpanID())
+	}
+	assert.Equal(t, codes.Unknown, spans[1].StatusCode())
+}
+
+func TestTracedTx_Rollback_EndsSpan(t *testing.T) {
+	t.Parallel()
+	db, recorder := newFakeTracedDB(t)
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	require.Nil(t, err)
+	require.Nil(t, tx.Rollback())
+	assert.Equal(t, sql.ErrTxDone, tx.Commit())
+	require.Len(t, recorder.Completed(), 1)
+}
@@ -0,0 +1,70 @@
+package infrastructure_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/infrastructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTraceProvider_WithStdoutExporter_WritesSpans(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	tp, err := infrastructure.NewTraceProvider(&infrastructure.TracingSettings{
+		ServiceName: "gateway",
+		Exporter:    "stdout",
+		SampleRatio: 1,
+		Writer:      &buf,
+	})
+	assert.Nil(t, err)
+
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.End()
+	assert.Nil(t, tp.Close())
+
+	var spans []struct {
+		Name     string
+		Resource []struct {
+			Key   string
+			Value struct{ Value string }
+		}
+	}
+	assert.Nil(t, json.Unmarshal(buf.Bytes(), &spans))
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "span", spans[0].Name)
+	assert.Equal(t, "service.name", spans[0].Resource[0].Key)
+	assert.Equal(t, "gateway", spans[0].Resource[0].Value.Value)
+}
+
+func TestNewTraceProvider_WhenNotSampled_WritesNoSpans(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	tp, err := infrastructure.NewTraceProvider(&infrastructure.TracingSettings{
+		Exporter:    "stdout",
+		SampleRatio: 0,
+		Writer:      &buf,
+	})
+	assert.Nil(t, err)
+
+	_, span := tp.Tracer("test").Start(context.Background(), "span")
+	span.End()
+	assert.Nil(t, tp.Close())
+	assert.Equal(t, "", buf.String())
+}
+
+func TestNewTraceProvider_WithNoExporter_ReturnsProvider(t *testing.T) {
+	t.Parallel()
+	tp, err := infrastructure.NewTraceProvider(&infrastructure.TracingSettings{Exporter: "none"})
+	assert.Nil(t, err)
+	assert.Nil(t, tp.Close())
+}
+
+func TestNewTraceProvider_WithUnknownExporter_ReturnsError(t *testing.T) {
+	t.Parallel()
+	_, err := infrastructure.NewTraceProvider(&infrastructure.TracingSettings{Exporter: "zipkin"})
+	assert.EqualError(t, err, "unknown trace exporter 'zipkin'")
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// apiKeyPrefix prefixes API keys so that they are recognisable, for instance
+// by secret scanners.
+const apiKeyPrefix = "bk_"
+
+// NewAPIKeyInteractor creates a new API key interactor. API keys can only be
+// granted the scopes the policy grants the caller creating them, so callers
+// cannot escalate their privileges through API keys. A nil policy, for when
+// callers are not authenticated, does not restrict the scopes.
+func NewAPIKeyInteractor(repo APIKeyRepository, policy *domain.Policy) *APIKeyInteractor {
+	return &APIKeyInteractor{repo: repo, policy: policy}
+}
+
+// APIKeyInteractor describes a set of APIs for managing API keys and
+// authenticating callers by them.
+type APIKeyInteractor struct {
+	repo   APIKeyRepository
+	policy *domain.Policy
+}
+
+// CreateAPIKey is an API for creating an API key. The key itself is returned
+// along with the API key and cannot be retrieved again. Creating an API key
+// with a scope the caller does not hold fails with a PermissionDeniedError.
+func (interactor *APIKeyInteractor) CreateAPIKey(ctx context.Context, params *domain.CreateAPIKeyParams) (*domain.APIKey, string, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyInteractor.CreateAPIKey")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, "", err
+	}
+	err = interactor.checkScopes(ctx, params.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey, err := interactor.repo.CreateAPIKey(ctx, &domain.APIKey{
+		Name:       params.Name,
+		Hash:       domain.HashAPIKey(key),
+		Scopes:     params.Scopes,
+		CreateTime: time.Now().UTC(),
+		ExpireTime: params.ExpireTime.UTC(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// checkScopes returns a PermissionDeniedError if the policy does not grant the
+// principal of the context any of the scopes.
+func (interactor *APIKeyInteractor) checkScopes(ctx context.Context, scopes []domain.Permission) error {
+	if interactor.policy == nil {
+		return nil
+	}
+	principal, _ := domain.PrincipalFromContext(ctx)
+	for _, scope := range scopes {
+		if interactor.policy.Authorize(principal, scope) != nil {
+			return domain.NewPermissionDeniedError(fmt.Sprintf("scope '%s' not held by caller", scope))
+		}
+	}
+	return nil
+}
+
+// ListAPIKeys is an API for listing all API keys.
+func (interactor *APIKeyInteractor) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyInteractor.ListAPIKeys")
+	defer span.End()
+
+	keys, err := interactor.repo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey is an API for revoking an API key given its ID.
+func (interactor *APIKeyInteractor) RevokeAPIKey(ctx context.Context, params *domain.RevokeAPIKeyParams) error {
+	ctx, span := tracer.Start(ctx, "APIKeyInteractor.RevokeAPIKey")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = interactor.repo.RevokeAPIKey(ctx, params, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Authenticate is an API for authenticating a caller by an API key. It
+// returns the principal of the caller, granted the scopes of the API key, or
+// an UnauthenticatedError if the key is unknown, expired or revoked.
+func (interactor *APIKeyInteractor) Authenticate(ctx context.Context, key string) (*domain.Principal, error) {
+	ctx, span := tracer.Start(ctx, "APIKeyInteractor.Authenticate")
+	defer span.End()
+
+	apiKey, err := interactor.repo.GetAPIKeyByHash(ctx, domain.HashAPIKey(key))
+	if err != nil {
+		if errors.As(err, &domain.NotFoundError{}) {
+			return nil, domain.NewUnauthenticatedError("invalid api key")
+		}
+		return nil, err
+	}
+	if !apiKey.Active(time.Now()) {
+		return nil, domain.NewUnauthenticatedError("invalid api key")
+	}
+	return &domain.Principal{
+		Subject: fmt.Sprintf("apikey:%s", apiKey.ID),
+		Scopes:  apiKey.Scopes,
+	}, nil
+}
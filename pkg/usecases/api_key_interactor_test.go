@@ -0,0 +1,258 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+	"github.com/bvwells/grpc-gateway-example/pkg/usecases"
+	"github.com/bvwells/grpc-gateway-example/pkg/usecases/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:generate mockery -name=APIKeyRepository -case=underscore
+
+func TestNewAPIKeyInteractor_ReturnsAPIKeyInteractor(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	assert.NotNil(t, usecases.NewAPIKeyInteractor(repo, nil))
+}
+
+func TestCreateAPIKey_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	_, _, err := interactor.CreateAPIKey(context.Background(), &domain.CreateAPIKeyParams{})
+	assert.NotNil(t, err)
+}
+
+func TestCreateAPIKey_WhenCreateAPIKeyReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	expected := errors.New("something went wrong")
+	repo.On("CreateAPIKey", derivedFrom(ctx), mock.Anything).Return(nil, expected)
+	_, _, actual := interactor.CreateAPIKey(ctx, &domain.CreateAPIKeyParams{
+		Name:       "name",
+		Scopes:     []domain.Permission{domain.PermissionListBeers},
+		ExpireTime: time.Now().Add(time.Hour),
+	})
+	assert.Equal(t, expected, actual)
+}
+
+func TestCreateAPIKey_WhenCreateAPIKeyReturnsAPIKey_ReturnsAPIKeyAndKey(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	params := &domain.CreateAPIKeyParams{
+		Name:       "name",
+		Scopes:     []domain.Permission{domain.PermissionListBeers},
+		ExpireTime: time.Now().Add(time.Hour),
+	}
+	var created *domain.APIKey
+	repo.On("CreateAPIKey", derivedFrom(ctx), mock.Anything).
+		Return(func(ctx context.Context, key *domain.APIKey) *domain.APIKey {
+			created = key
+			key.ID = "id"
+			return key
+		}, nil)
+
+	apiKey, key, err := interactor.CreateAPIKey(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, created, apiKey)
+	assert.Equal(t, "id", apiKey.ID)
+	assert.Equal(t, "name", apiKey.Name)
+	assert.Equal(t, params.Scopes, apiKey.Scopes)
+	assert.True(t, params.ExpireTime.Equal(apiKey.ExpireTime))
+	assert.False(t, apiKey.CreateTime.IsZero())
+	assert.True(t, strings.HasPrefix(key, "bk_"))
+	assert.Equal(t, domain.HashAPIKey(key), apiKey.Hash)
+
+	_, other, err := interactor.CreateAPIKey(ctx, params)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestCreateAPIKey_WithPolicy_OnlyGrantsScopesHeldByCaller(t *testing.T) {
+	t.Parallel()
+	editor := &domain.Principal{Subject: "editor", Roles: []string{domain.RoleEditor}}
+	apiKey := &domain.Principal{Subject: "apikey:id", Scopes: []domain.Permission{
+		domain.PermissionCreateAPIKeys, domain.PermissionListBeers,
+	}}
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		scopes    []domain.Permission
+		err       error
+	}{
+		{
+			name:      "scopes granted to role",
+			principal: editor,
+			scopes:    []domain.Permission{domain.PermissionGetBeers, domain.PermissionCreateBeers},
+		},
+		{
+			name:      "scope not granted to role",
+			principal: editor,
+			scopes:    []domain.Permission{domain.PermissionCreateBeers, domain.PermissionDeleteBeers},
+			err:       domain.NewPermissionDeniedError("scope 'beers.delete' not held by caller"),
+		},
+		{
+			name:      "scope of api key",
+			principal: apiKey,
+			scopes:    []domain.Permission{domain.PermissionListBeers},
+		},
+		{
+			name:      "scope not of api key",
+			principal: apiKey,
+			scopes:    []domain.Permission{domain.PermissionGetBeers},
+			err:       domain.NewPermissionDeniedError("scope 'beers.get' not held by caller"),
+		},
+		{
+			name:   "no principal",
+			scopes: []domain.Permission{domain.PermissionGetBeers},
+			err:    domain.NewPermissionDeniedError("scope 'beers.get' not held by caller"),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			s.Parallel()
+			repo := &mocks.APIKeyRepository{}
+			interactor := usecases.NewAPIKeyInteractor(repo, domain.DefaultPolicy())
+			ctx := newContext()
+			if test.principal != nil {
+				ctx = domain.NewContextWithPrincipal(ctx, test.principal)
+			}
+			repo.On("CreateAPIKey", derivedFrom(ctx), mock.Anything).
+				Return(func(ctx context.Context, key *domain.APIKey) *domain.APIKey { return key }, nil)
+
+			_, _, err := interactor.CreateAPIKey(ctx, &domain.CreateAPIKeyParams{
+				Name:       "name",
+				Scopes:     test.scopes,
+				ExpireTime: time.Now().Add(time.Hour),
+			})
+			assert.Equal(s, test.err, err)
+			if test.err != nil {
+				repo.AssertNotCalled(s, "CreateAPIKey", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestListAPIKeys_WhenListAPIKeysReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	expected := errors.New("something went wrong")
+	repo.On("ListAPIKeys", derivedFrom(ctx)).Return(nil, expected)
+	_, actual := interactor.ListAPIKeys(ctx)
+	assert.Equal(t, expected, actual)
+}
+
+func TestListAPIKeys_WhenListAPIKeysReturnsAPIKeys_ReturnsAPIKeys(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	expected := []*domain.APIKey{{ID: "id"}}
+	repo.On("ListAPIKeys", derivedFrom(ctx)).Return(expected, nil)
+	actual, err := interactor.ListAPIKeys(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestRevokeAPIKey_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	err := interactor.RevokeAPIKey(context.Background(), &domain.RevokeAPIKeyParams{})
+	assert.NotNil(t, err)
+}
+
+func TestRevokeAPIKey_WhenRevokeAPIKeyReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	params := &domain.RevokeAPIKeyParams{ID: "id"}
+	expected := errors.New("something went wrong")
+	repo.On("RevokeAPIKey", derivedFrom(ctx), params, mock.Anything).Return(expected)
+	actual := interactor.RevokeAPIKey(ctx, params)
+	assert.Equal(t, expected, actual)
+}
+
+func TestRevokeAPIKey_WhenRevokeAPIKeySucceeds_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	params := &domain.RevokeAPIKeyParams{ID: "id"}
+	repo.On("RevokeAPIKey", derivedFrom(ctx), params, mock.AnythingOfType("time.Time")).Return(nil)
+	assert.Nil(t, interactor.RevokeAPIKey(ctx, params))
+	repo.AssertExpectations(t)
+}
+
+func TestAuthenticate_WhenAPIKeyActive_ReturnsPrincipal(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	ctx := newContext()
+	scopes := []domain.Permission{domain.PermissionListBeers}
+	repo.On("GetAPIKeyByHash", derivedFrom(ctx), domain.HashAPIKey("key")).
+		Return(&domain.APIKey{ID: "id", Scopes: scopes, ExpireTime: time.Now().Add(time.Hour)}, nil)
+	principal, err := interactor.Authenticate(ctx, "key")
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.Principal{Subject: "apikey:id", Scopes: scopes}, principal)
+}
+
+func TestAuthenticate_WhenAPIKeyInvalid_ReturnsUnauthenticatedError(t *testing.T) {
+	t.Parallel()
+	revoked := time.Now().Add(-time.Minute)
+	tests := []struct {
+		name   string
+		apiKey *domain.APIKey
+		err    error
+	}{
+		{
+			name: "unknown",
+			err:  domain.NewNotFoundError("api key not found"),
+		},
+		{
+			name:   "expired",
+			apiKey: &domain.APIKey{ID: "id", ExpireTime: time.Now().Add(-time.Minute)},
+		},
+		{
+			name:   "revoked",
+			apiKey: &domain.APIKey{ID: "id", ExpireTime: time.Now().Add(time.Hour), RevokeTime: &revoked},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			repo := &mocks.APIKeyRepository{}
+			interactor := usecases.NewAPIKeyInteractor(repo, nil)
+			repo.On("GetAPIKeyByHash", mock.Anything, domain.HashAPIKey("key")).Return(test.apiKey, test.err)
+			_, err := interactor.Authenticate(context.Background(), "key")
+			assert.Equal(s, domain.NewUnauthenticatedError("invalid api key"), err)
+		})
+	}
+}
+
+func TestAuthenticate_WhenGetAPIKeyByHashReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.APIKeyRepository{}
+	interactor := usecases.NewAPIKeyInteractor(repo, nil)
+	expected := errors.New("something went wrong")
+	repo.On("GetAPIKeyByHash", mock.Anything, mock.Anything).Return(nil, expected)
+	_, actual := interactor.Authenticate(context.Background(), "key")
+	assert.Equal(t, expected, actual)
+}
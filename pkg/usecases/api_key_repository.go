@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// APIKeyRepository is a repository for API keys.
+type APIKeyRepository interface {
+	// CreateAPIKey creates an API key, assigning it an ID.
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error)
+	// GetAPIKeyByHash gets the API key with the hash.
+	GetAPIKeyByHash(ctx context.Context, hash []byte) (*domain.APIKey, error)
+	// ListAPIKeys lists all API keys.
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+	// RevokeAPIKey revokes an API key at the given time.
+	RevokeAPIKey(ctx context.Context, params *domain.RevokeAPIKeyParams, revokeTime time.Time) error
+}
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// AuditRepository is a repository for the audit entries of beers. The
+// entries are recorded by the beer repository in the transactions of the
+// mutations they audit.
+type AuditRepository interface {
+	// ListAuditEntries lists the audit entries of a beer in the order they
+	// were created.
+	ListAuditEntries(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error)
+}
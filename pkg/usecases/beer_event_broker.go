@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+)
+
+// BeerEventBroker is a broker of the events of beers.
+type BeerEventBroker interface {
+	// Publish publishes an event, setting its resume token. An event of a
+	// beer older than an event of the beer already published may be dropped
+	// instead, so that watchers see the versions of a beer in order.
+	Publish(ctx context.Context, event *domain.BeerEvent)
+	// Watch calls the handler with the published events in the order they
+	// were published, until the context is done, the handler returns an
+	// error or the broker is closed. The handler must not modify the events.
+	Watch(ctx context.Context, params *domain.WatchBeersParams, handler func(*domain.BeerEvent) error) error
+}
@@ -2,36 +2,59 @@ package usecases
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
+
+	"go.opentelemetry.io/otel/api/global"
 )
 
-// NewBeerInteractor creates a new beer interactor.
-func NewBeerInteractor(repo BeerRepository) *BeerInteractor {
-	return &BeerInteractor{repo: repo}
+var tracer = global.Tracer("github.com/bvwells/grpc-gateway-example/pkg/usecases")
+
+// NewBeerInteractor creates a new beer interactor which publishes the
+// mutations of beers to the event broker and lists the history of beers from
+// the audit repository. Batches of more than maxBatchSize beers are rejected.
+func NewBeerInteractor(repo BeerRepository, audit AuditRepository, events BeerEventBroker,
+	maxBatchSize int) *BeerInteractor {
+	return &BeerInteractor{repo: repo, audit: audit, events: events, maxBatchSize: maxBatchSize}
 }
 
-// BeerInteractor describes a set of APIs for interacting with beers.
+// BeerInteractor describes a set of APIs for interacting with beers. Every
+// mutation of a beer is recorded in an audit entry by the repository in the
+// same transaction as the mutation, so a beer is never mutated without its
+// audit entry, and is published as an event once the mutation is committed.
 type BeerInteractor struct {
-	repo BeerRepository
+	repo         BeerRepository
+	audit        AuditRepository
+	events       BeerEventBroker
+	maxBatchSize int
 }
 
 // CreateBeer is an API for getting a beer given its ID.
 func (interactor *BeerInteractor) CreateBeer(ctx context.Context, params *domain.CreateBeerParams) (*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.CreateBeer")
+	defer span.End()
+
 	err := params.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	beer, err := interactor.repo.CreateBeer(ctx, params)
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beer, err := interactor.repo.CreateBeer(ctx, params, audit)
 	if err != nil {
 		return nil, err
 	}
+	interactor.publish(ctx, domain.BeerCreated, beer, audit.Time)
 	return beer, nil
 }
 
 // GetBeer is an API for getting a beer given its ID.
 func (interactor *BeerInteractor) GetBeer(ctx context.Context, params *domain.GetBeerParams) (*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.GetBeer")
+	defer span.End()
+
 	err := params.Validate()
 	if err != nil {
 		return nil, err
@@ -46,42 +69,294 @@ func (interactor *BeerInteractor) GetBeer(ctx context.Context, params *domain.Ge
 
 // UpdateBeer is an API for updating a beer given its ID.
 func (interactor *BeerInteractor) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.UpdateBeer")
+	defer span.End()
+
 	err := params.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	beer, err := interactor.repo.UpdateBeer(ctx, params)
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beer, err := interactor.repo.UpdateBeer(ctx, params, audit)
 	if err != nil {
 		return nil, err
 	}
+	interactor.publish(ctx, domain.BeerUpdated, beer, audit.Time)
 	return beer, nil
 }
 
-// DeleteBeer is an API for deleting a beer given its ID.
+// DeleteBeer is an API for deleting a beer given its ID. Deleted beers can be
+// undeleted until they are purged.
 func (interactor *BeerInteractor) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams) error {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.DeleteBeer")
+	defer span.End()
+
 	err := params.Validate()
 	if err != nil {
 		return err
 	}
 
-	err = interactor.repo.DeleteBeer(ctx, params)
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beer, err := interactor.repo.DeleteBeer(ctx, params, audit)
 	if err != nil {
 		return err
 	}
+	interactor.publish(ctx, domain.BeerDeleted, beer, audit.Time)
 	return nil
 }
 
-// ListBeers is an API for listing beers.
-func (interactor *BeerInteractor) ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error) {
+// UndeleteBeer is an API for undeleting a deleted beer given its ID.
+func (interactor *BeerInteractor) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams) (*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.UndeleteBeer")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beer, err := interactor.repo.UndeleteBeer(ctx, params, audit)
+	if err != nil {
+		return nil, err
+	}
+	interactor.publish(ctx, domain.BeerUpdated, beer, audit.Time)
+	return beer, nil
+}
+
+// PurgeBeers is an API for permanently removing the beers deleted for longer
+// than the retention period. It returns the number of beers removed.
+func (interactor *BeerInteractor) PurgeBeers(ctx context.Context, retention time.Duration) (int, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.PurgeBeers")
+	defer span.End()
+
+	if retention < 0 {
+		return 0, domain.NewValidationError("retention less than zero")
+	}
+
+	return interactor.repo.PurgeBeers(ctx, time.Now().UTC().Add(-retention))
+}
+
+// BatchGetBeers is an API for getting a batch of beers given their IDs, in
+// the order of the IDs. Either every beer is got or an error is returned.
+func (interactor *BeerInteractor) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.BatchGetBeers")
+	defer span.End()
+
 	err := params.Validate()
 	if err != nil {
 		return nil, err
 	}
+	err = interactor.checkBatchSize(len(params.IDs))
+	if err != nil {
+		return nil, err
+	}
 
-	beers, err := interactor.repo.ListBeers(ctx, params)
+	beers, err := interactor.repo.BatchGetBeers(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 	return beers, nil
 }
+
+// BatchCreateBeers is an API for creating a batch of beers. Either every beer
+// is created or none are.
+func (interactor *BeerInteractor) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams) ([]*domain.Beer, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.BatchCreateBeers")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = interactor.checkBatchSize(len(params.Beers))
+	if err != nil {
+		return nil, err
+	}
+
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beers, err := interactor.repo.BatchCreateBeers(ctx, params, audit)
+	if err != nil {
+		return nil, err
+	}
+	for _, beer := range beers {
+		interactor.publish(ctx, domain.BeerCreated, beer, audit.Time)
+	}
+	return beers, nil
+}
+
+// BatchDeleteBeers is an API for deleting a batch of beers given their IDs.
+// Either every beer is deleted or none are.
+func (interactor *BeerInteractor) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams) error {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.BatchDeleteBeers")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return err
+	}
+	err = interactor.checkBatchSize(len(params.Beers))
+	if err != nil {
+		return err
+	}
+
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	beers, err := interactor.repo.BatchDeleteBeers(ctx, params, audit)
+	if err != nil {
+		return err
+	}
+	for _, beer := range beers {
+		interactor.publish(ctx, domain.BeerDeleted, beer, audit.Time)
+	}
+	return nil
+}
+
+// checkBatchSize checks a batch of size beers is not too large.
+func (interactor *BeerInteractor) checkBatchSize(size int) error {
+	if size > interactor.maxBatchSize {
+		return domain.NewValidationError(fmt.Sprintf("more than %d beers in batch", interactor.maxBatchSize))
+	}
+	return nil
+}
+
+// ListBeers is an API for listing a page of beers.
+func (interactor *BeerInteractor) ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.ListBeers")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case params.PageSize == 0:
+		params.PageSize = domain.DefaultPageSize
+	case params.PageSize > domain.MaxPageSize:
+		params.PageSize = domain.MaxPageSize
+	}
+
+	result, err := interactor.repo.ListBeers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SearchBeers is an API for searching beers, which returns the beers ranked
+// by how well they match the search with the matching words highlighted.
+func (interactor *BeerInteractor) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.SearchBeers")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case params.PageSize == 0:
+		params.PageSize = domain.DefaultPageSize
+	case params.PageSize > domain.MaxPageSize:
+		params.PageSize = domain.MaxPageSize
+	}
+
+	result, err := interactor.repo.SearchBeers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	terms := domain.SearchTerms(params.Query)
+	for _, r := range result.Results {
+		r.Snippets = domain.NewSnippets(r.Beer, terms)
+	}
+	return result, nil
+}
+
+// ListBeerHistory is an API for listing the audit entries of a beer given its
+// ID, in the order the beer was mutated.
+func (interactor *BeerInteractor) ListBeerHistory(ctx context.Context, params *domain.ListBeerHistoryParams) ([]*domain.AuditEntry, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.ListBeerHistory")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := interactor.audit.ListAuditEntries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WatchBeers is an API for watching the events of beers. The handler is
+// called with every event until the context is done or the handler returns an
+// error.
+func (interactor *BeerInteractor) WatchBeers(ctx context.Context, params *domain.WatchBeersParams,
+	handler func(*domain.BeerEvent) error) error {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.WatchBeers")
+	defer span.End()
+
+	return interactor.events.Watch(ctx, params, handler)
+}
+
+// ExportBeers is an API for exporting every beer. The handler is called with
+// every beer, as of a consistent snapshot of the beers, until the handler
+// returns an error.
+func (interactor *BeerInteractor) ExportBeers(ctx context.Context, params *domain.ExportBeersParams,
+	handler func(*domain.Beer) error) error {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.ExportBeers")
+	defer span.End()
+
+	return interactor.repo.ExportBeers(ctx, params, handler)
+}
+
+// ImportBeers is an API for importing a batch of beers. Invalid beers fail
+// without failing the rest of the batch, and beers with the ID of an existing
+// beer are skipped, so an import can be retried. The valid beers of the batch
+// are created together, and each creation is published and recorded as for
+// CreateBeer.
+func (interactor *BeerInteractor) ImportBeers(ctx context.Context, params *domain.ImportBeersParams) (*domain.ImportBeersResult, error) {
+	ctx, span := tracer.Start(ctx, "BeerInteractor.ImportBeers")
+	defer span.End()
+
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ImportBeersResult{}
+	var valid []*domain.Beer
+	for i, beer := range params.Beers {
+		if err := domain.ValidateImportedBeer(beer); err != nil {
+			result.Fail(i, beer.ID, err)
+			continue
+		}
+		valid = append(valid, beer)
+	}
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	audit := domain.NewAuditRecord(ctx, time.Now().UTC())
+	created, err := interactor.repo.ImportBeers(ctx, valid, audit)
+	if err != nil {
+		return nil, err
+	}
+	result.Created = len(created)
+	result.Skipped = len(valid) - len(created)
+
+	for _, beer := range created {
+		interactor.publish(ctx, domain.BeerCreated, beer, audit.Time)
+	}
+	return result, nil
+}
+
+// publish publishes the event of the change of a beer.
+func (interactor *BeerInteractor) publish(ctx context.Context, eventType domain.BeerEventType,
+	beer *domain.Beer, createTime time.Time) {
+	event := &domain.BeerEvent{Type: eventType, Beer: beer, CreateTime: createTime}
+	interactor.events.Publish(ctx, event)
+}
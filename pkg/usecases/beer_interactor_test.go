@@ -3,27 +3,72 @@ package usecases_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 	"github.com/bvwells/grpc-gateway-example/pkg/usecases"
 	"github.com/bvwells/grpc-gateway-example/pkg/usecases/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 //go:generate mockery -name=BeerRepository -case=underscore
+//go:generate mockery -name=AuditRepository -case=underscore
+//go:generate mockery -name=BeerEventBroker -case=underscore
+
+type contextKey struct{}
+
+// newContext returns a new context which can be told apart from other
+// contexts by derivedFrom.
+func newContext() context.Context {
+	return context.WithValue(context.Background(), contextKey{}, new(int))
+}
+
+// newBeerInteractor returns a new beer interactor with mock repositories and
+// event broker.
+func newBeerInteractor() (*usecases.BeerInteractor, *mocks.BeerRepository, *mocks.AuditRepository, *mocks.BeerEventBroker) {
+	repo := &mocks.BeerRepository{}
+	audit := &mocks.AuditRepository{}
+	events := &mocks.BeerEventBroker{}
+	return usecases.NewBeerInteractor(repo, audit, events, domain.DefaultMaxBatchSize), repo, audit, events
+}
+
+// publishes matches events of the type of change of the beer.
+func publishes(eventType domain.BeerEventType, beer *domain.Beer) interface{} {
+	return mock.MatchedBy(func(event *domain.BeerEvent) bool {
+		return event.Type == eventType && assert.ObjectsAreEqual(beer, event.Beer) && !event.CreateTime.IsZero()
+	})
+}
+
+// recordedBy matches audit records of mutations by the subject in the request.
+func recordedBy(subject, requestID string) interface{} {
+	return mock.MatchedBy(func(audit *domain.AuditRecord) bool {
+		return audit.Actor == subject && audit.RequestID == requestID && !audit.Time.IsZero()
+	})
+}
+
+// derivedFrom matches contexts derived from ctx. The interactor passes the
+// repository a context derived from the one it is called with, carrying the
+// interactor span.
+func derivedFrom(ctx context.Context) interface{} {
+	return mock.MatchedBy(func(c context.Context) bool {
+		return c.Value(contextKey{}) == ctx.Value(contextKey{})
+	})
+}
 
 func TestNewBeerInteractor_ReturnsBeerInteractor(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	assert.NotNil(t, usecases.NewBeerInteractor(repo))
+	assert.NotNil(t, usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize))
 }
 
 func TestCreateBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
 	_, err := interactor.CreateBeer(context.Background(), &domain.CreateBeerParams{})
 	assert.NotNil(t, err)
 }
@@ -31,31 +76,35 @@ func TestCreateBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 func TestCreateBeer_WhenCreateBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
 	params := &domain.CreateBeerParams{Name: "a beer"}
 	expected := errors.New("something went wrong")
-	repo.On("CreateBeer", ctx, params).Return(nil, expected)
+	repo.On("CreateBeer", derivedFrom(ctx), params, mock.AnythingOfType("*domain.AuditRecord")).Return(nil, expected)
 	_, actual := interactor.CreateBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
 
 func TestCreateBeer_WhenCreateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
-	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithPrincipal(domain.NewContextWithRequestID(newContext(), "request"),
+		&domain.Principal{Subject: "subject"})
 	params := &domain.CreateBeerParams{Name: "a beer"}
-	expected := &domain.Beer{ID: "id"}
-	repo.On("CreateBeer", ctx, params).Return(expected, nil)
-	actual, _ := interactor.CreateBeer(ctx, params)
+	expected := &domain.Beer{ID: "id", Name: "a beer"}
+	repo.On("CreateBeer", derivedFrom(ctx), params, recordedBy("subject", "request")).Return(expected, nil)
+	events.On("Publish", derivedFrom(ctx), publishes(domain.BeerCreated, expected)).Return()
+	actual, err := interactor.CreateBeer(ctx, params)
+	assert.Nil(t, err)
 	assert.Equal(t, expected, actual)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
 }
 
 func TestGetBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
 	_, err := interactor.GetBeer(context.Background(), &domain.GetBeerParams{})
 	assert.NotNil(t, err)
 }
@@ -63,11 +112,11 @@ func TestGetBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 func TestGetBeer_WhenGetBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
 	params := &domain.GetBeerParams{ID: "ID"}
 	expected := errors.New("something went wrong")
-	repo.On("GetBeer", ctx, params).Return(nil, expected)
+	repo.On("GetBeer", derivedFrom(ctx), params).Return(nil, expected)
 	_, actual := interactor.GetBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
@@ -75,11 +124,11 @@ func TestGetBeer_WhenGetBeerReturnsError_ReturnsError(t *testing.T) {
 func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
 	params := &domain.GetBeerParams{ID: "ID"}
 	expected := &domain.Beer{ID: "id"}
-	repo.On("GetBeer", ctx, params).Return(expected, nil)
+	repo.On("GetBeer", derivedFrom(ctx), params).Return(expected, nil)
 	actual, _ := interactor.GetBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
@@ -87,7 +136,7 @@ func TestGetBeer_WhenGetBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 func TestUpdateBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
 	_, err := interactor.UpdateBeer(context.Background(), &domain.UpdateBeerParams{})
 	assert.NotNil(t, err)
 }
@@ -95,33 +144,36 @@ func TestUpdateBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 func TestUpdateBeer_WhenUpdateBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
 	brewer := "brewer"
 	params := &domain.UpdateBeerParams{ID: "ID", Brewer: &brewer}
 	expected := errors.New("something went wrong")
-	repo.On("UpdateBeer", ctx, params).Return(nil, expected)
+	repo.On("UpdateBeer", derivedFrom(ctx), params, mock.AnythingOfType("*domain.AuditRecord")).Return(nil, expected)
 	_, actual := interactor.UpdateBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
 
 func TestUpdateBeer_WhenUpdateBeerReturnsBeer_ReturnsBeer(t *testing.T) {
 	t.Parallel()
-	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithPrincipal(newContext(), &domain.Principal{Subject: "subject"})
 	brewer := "brewer"
 	params := &domain.UpdateBeerParams{ID: "ID", Brewer: &brewer}
-	expected := &domain.Beer{ID: "id"}
-	repo.On("UpdateBeer", ctx, params).Return(expected, nil)
-	actual, _ := interactor.UpdateBeer(ctx, params)
+	expected := &domain.Beer{ID: "ID", Brewer: brewer}
+	repo.On("UpdateBeer", derivedFrom(ctx), params, recordedBy("subject", "")).Return(expected, nil)
+	events.On("Publish", derivedFrom(ctx), publishes(domain.BeerUpdated, expected)).Return()
+	actual, err := interactor.UpdateBeer(ctx, params)
+	assert.Nil(t, err)
 	assert.Equal(t, expected, actual)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
 }
 
 func TestDeleteBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
 	err := interactor.DeleteBeer(context.Background(), &domain.DeleteBeerParams{})
 	assert.NotNil(t, err)
 }
@@ -129,42 +181,228 @@ func TestDeleteBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 func TestDeleteBeer_WhenDeleteBeerReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
 	params := &domain.DeleteBeerParams{ID: "ID"}
 	expected := errors.New("something went wrong")
-	repo.On("DeleteBeer", ctx, params).Return(expected)
+	repo.On("DeleteBeer", derivedFrom(ctx), params, mock.AnythingOfType("*domain.AuditRecord")).Return(nil, expected)
 	actual := interactor.DeleteBeer(ctx, params)
 	assert.Equal(t, expected, actual)
 }
 
-func TestDeleteBeer_WhenDeleteBeerReturnsNilReturnsBeer(t *testing.T) {
+func TestDeleteBeer_WhenDeleteBeerReturnsBeer_PublishesDeletedBeer(t *testing.T) {
 	t.Parallel()
-	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := newContext()
 	params := &domain.DeleteBeerParams{ID: "ID"}
-	repo.On("DeleteBeer", ctx, params).Return(nil)
+	var deleted *domain.Beer
+	repo.On("DeleteBeer", derivedFrom(ctx), params, recordedBy("", "")).
+		Run(func(args mock.Arguments) {
+			deleteTime := args.Get(2).(*domain.AuditRecord).Time
+			deleted = &domain.Beer{ID: "ID", Name: "name", Version: 2, DeleteTime: &deleteTime}
+		}).
+		Return(func(context.Context, *domain.DeleteBeerParams, *domain.AuditRecord) *domain.Beer { return deleted }, nil)
+	events.On("Publish", derivedFrom(ctx), mock.MatchedBy(func(event *domain.BeerEvent) bool {
+		return event.Type == domain.BeerDeleted &&
+			event.Beer == deleted &&
+			event.CreateTime.Equal(*deleted.DeleteTime)
+	})).Return()
 	actual := interactor.DeleteBeer(ctx, params)
 	assert.Nil(t, actual)
+	events.AssertExpectations(t)
+}
+
+func TestUndeleteBeer_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.BeerRepository{}
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	_, err := interactor.UndeleteBeer(context.Background(), &domain.UndeleteBeerParams{})
+	assert.NotNil(t, err)
+}
+
+func TestUndeleteBeer_WhenUndeleteBeerReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.BeerRepository{}
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
+	params := &domain.UndeleteBeerParams{ID: "ID"}
+	expected := errors.New("something went wrong")
+	repo.On("UndeleteBeer", derivedFrom(ctx), params, mock.AnythingOfType("*domain.AuditRecord")).Return(nil, expected)
+	_, actual := interactor.UndeleteBeer(ctx, params)
+	assert.Equal(t, expected, actual)
+}
+
+func TestUndeleteBeer_WhenUndeleteBeerReturnsBeer_ReturnsBeer(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithRequestID(newContext(), "request")
+	params := &domain.UndeleteBeerParams{ID: "ID"}
+	expected := &domain.Beer{ID: "ID", Version: 3}
+	repo.On("UndeleteBeer", derivedFrom(ctx), params, recordedBy("", "request")).Return(expected, nil)
+	events.On("Publish", derivedFrom(ctx), publishes(domain.BeerUpdated, expected)).Return()
+	actual, err := interactor.UndeleteBeer(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	events.AssertExpectations(t)
+}
+
+func TestPurgeBeers_WhenRetentionNegative_ReturnsError(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.BeerRepository{}
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	_, err := interactor.PurgeBeers(context.Background(), -time.Hour)
+	assert.Equal(t, domain.NewValidationError("retention less than zero"), err)
+}
+
+func TestPurgeBeers_PurgesBeersDeletedBeforeRetention(t *testing.T) {
+	t.Parallel()
+	repo := &mocks.BeerRepository{}
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
+	retention := 24 * time.Hour
+	before := time.Now().UTC().Add(-retention)
+	repo.On("PurgeBeers", derivedFrom(ctx), mock.MatchedBy(func(deletedBefore time.Time) bool {
+		return !deletedBefore.Before(before) && deletedBefore.Before(before.Add(time.Minute))
+	})).Return(2, nil)
+	purged, err := interactor.PurgeBeers(ctx, retention)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, purged)
+	repo.AssertExpectations(t)
+}
+
+func TestBatchGetBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	_, err := interactor.BatchGetBeers(context.Background(), &domain.BatchGetBeersParams{})
+	assert.Equal(t, domain.NewValidationError("no beers in batch"), err)
+}
+
+func TestBatchBeers_WhenBatchTooLarge_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor := usecases.NewBeerInteractor(&mocks.BeerRepository{}, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, 1)
+	ctx := context.Background()
+	expected := domain.NewValidationError("more than 1 beers in batch")
+	_, err := interactor.BatchGetBeers(ctx, &domain.BatchGetBeersParams{IDs: []string{"a", "b"}})
+	assert.Equal(t, expected, err)
+	_, err = interactor.BatchCreateBeers(ctx, &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}, {Name: "b"}}})
+	assert.Equal(t, expected, err)
+	err = interactor.BatchDeleteBeers(ctx, &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {ID: "b"}}})
+	assert.Equal(t, expected, err)
+}
+
+func TestBatchGetBeers_WhenBatchGetBeersReturnsBeers_ReturnsBeers(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.BatchGetBeersParams{IDs: []string{"b", "a"}}
+	expected := []*domain.Beer{{ID: "b"}, {ID: "a"}}
+	repo.On("BatchGetBeers", derivedFrom(ctx), params).Return(expected, nil)
+	actual, err := interactor.BatchGetBeers(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBatchGetBeers_WhenBatchGetBeersReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.BatchGetBeersParams{IDs: []string{"a"}}
+	expected := domain.NewNotFoundError("beer 'a' not found")
+	repo.On("BatchGetBeers", derivedFrom(ctx), params).Return(nil, expected)
+	_, actual := interactor.BatchGetBeers(ctx, params)
+	assert.Equal(t, expected, actual)
+}
+
+func TestBatchCreateBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	params := &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{}}}
+	_, err := interactor.BatchCreateBeers(context.Background(), params)
+	assert.Equal(t, domain.NewValidationError("requests[0]: beer name is empty"), err)
+}
+
+func TestBatchCreateBeers_WhenBatchCreateBeersReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}}}
+	expected := errors.New("something went wrong")
+	repo.On("BatchCreateBeers", derivedFrom(ctx), params, recordedBy("", "")).Return(nil, expected)
+	_, actual := interactor.BatchCreateBeers(ctx, params)
+	assert.Equal(t, expected, actual)
+	events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestBatchCreateBeers_WhenBatchCreateBeersReturnsBeers_PublishesBeers(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithRequestID(newContext(), "request")
+	params := &domain.BatchCreateBeersParams{Beers: []*domain.CreateBeerParams{{Name: "a"}, {Name: "b"}}}
+	expected := []*domain.Beer{{ID: "id1", Name: "a", Version: 1}, {ID: "id2", Name: "b", Version: 1}}
+	repo.On("BatchCreateBeers", derivedFrom(ctx), params, recordedBy("", "request")).Return(expected, nil)
+	for _, beer := range expected {
+		events.On("Publish", derivedFrom(ctx), publishes(domain.BeerCreated, beer)).Return().Once()
+	}
+	actual, err := interactor.BatchCreateBeers(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestBatchDeleteBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	params := &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {ID: "a"}}}
+	err := interactor.BatchDeleteBeers(context.Background(), params)
+	assert.Equal(t, domain.NewValidationError("requests[1]: beer 'a' is already in batch"), err)
+}
+
+func TestBatchDeleteBeers_WhenBatchDeleteBeersReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}}}
+	expected := domain.NewConflictError("beer 'a' has been modified")
+	repo.On("BatchDeleteBeers", derivedFrom(ctx), params, recordedBy("", "")).Return(nil, expected)
+	err := interactor.BatchDeleteBeers(ctx, params)
+	assert.Equal(t, expected, err)
+	events.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestBatchDeleteBeers_WhenBatchDeleteBeersReturnsBeers_PublishesDeletedBeers(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithRequestID(newContext(), "request")
+	params := &domain.BatchDeleteBeersParams{Beers: []*domain.DeleteBeerParams{{ID: "a"}, {ID: "b"}}}
+	deleteTime := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	deleted := []*domain.Beer{{ID: "a", Version: 2, DeleteTime: &deleteTime}, {ID: "b", Version: 4, DeleteTime: &deleteTime}}
+	repo.On("BatchDeleteBeers", derivedFrom(ctx), params, recordedBy("", "request")).Return(deleted, nil)
+	for _, beer := range deleted {
+		events.On("Publish", derivedFrom(ctx), publishes(domain.BeerDeleted, beer)).Return().Once()
+	}
+	err := interactor.BatchDeleteBeers(ctx, params)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
 }
 
 func TestListBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	_, err := interactor.ListBeers(context.Background(), &domain.ListBeersParams{})
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	_, err := interactor.ListBeers(context.Background(), &domain.ListBeersParams{PageSize: -1})
 	assert.NotNil(t, err)
 }
 
 func TestListBeers_WhenListBeersReturnsError_ReturnsError(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
-	params := &domain.ListBeersParams{Page: 1}
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
+	params := &domain.ListBeersParams{PageSize: 10}
 	expected := errors.New("something went wrong")
-	repo.On("ListBeers", ctx, params).Return(nil, expected)
+	repo.On("ListBeers", derivedFrom(ctx), params).Return(nil, expected)
 	_, actual := interactor.ListBeers(ctx, params)
 	assert.Equal(t, expected, actual)
 }
@@ -172,14 +410,278 @@ func TestListBeers_WhenListBeersReturnsError_ReturnsError(t *testing.T) {
 func TestListBeers_WhenListBeersReturnsBeers_ReturnsBeers(t *testing.T) {
 	t.Parallel()
 	repo := &mocks.BeerRepository{}
-	interactor := usecases.NewBeerInteractor(repo)
-	ctx := context.Background()
-	params := &domain.ListBeersParams{Page: 1}
-	expected := []*domain.Beer{
-		{ID: "id1"},
-		{ID: "id2"},
+	interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+	ctx := newContext()
+	params := &domain.ListBeersParams{PageSize: 10}
+	expected := &domain.ListBeersResult{
+		Beers: []*domain.Beer{
+			{ID: "id1"},
+			{ID: "id2"},
+		},
+		Next:      &domain.Cursor{ID: "id2"},
+		TotalSize: 5,
 	}
-	repo.On("ListBeers", ctx, params).Return(expected, nil)
+	repo.On("ListBeers", derivedFrom(ctx), params).Return(expected, nil)
 	actual, _ := interactor.ListBeers(ctx, params)
 	assert.Equal(t, expected, actual)
 }
+
+func TestListBeers_CoercesPageSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		pageSize int
+		expected int
+	}{
+		{
+			name:     "default page size",
+			pageSize: 0,
+			expected: domain.DefaultPageSize,
+		},
+		{
+			name:     "page size within limits",
+			pageSize: 50,
+			expected: 50,
+		},
+		{
+			name:     "page size above maximum",
+			pageSize: domain.MaxPageSize + 1,
+			expected: domain.MaxPageSize,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			repo := &mocks.BeerRepository{}
+			interactor := usecases.NewBeerInteractor(repo, &mocks.AuditRepository{}, &mocks.BeerEventBroker{}, domain.DefaultMaxBatchSize)
+			ctx := newContext()
+			repo.On("ListBeers", derivedFrom(ctx), &domain.ListBeersParams{PageSize: test.expected}).Return(&domain.ListBeersResult{}, nil)
+			_, err := interactor.ListBeers(ctx, &domain.ListBeersParams{PageSize: test.pageSize})
+			assert.Nil(s, err)
+			repo.AssertExpectations(s)
+		})
+	}
+}
+
+func TestSearchBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	_, err := interactor.SearchBeers(context.Background(), &domain.SearchBeersParams{Query: " "})
+	assert.Equal(t, domain.NewValidationError("search query is empty"), err)
+}
+
+func TestSearchBeers_WhenSearchBeersReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.SearchBeersParams{Query: "orval", PageSize: 10}
+	expected := errors.New("something went wrong")
+	repo.On("SearchBeers", derivedFrom(ctx), params).Return(nil, expected)
+	_, actual := interactor.SearchBeers(ctx, params)
+	assert.Equal(t, expected, actual)
+}
+
+func TestSearchBeers_WhenSearchBeersReturnsResults_ReturnsResultsWithSnippets(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.SearchBeersParams{Query: "westmale trip"}
+	repo.On("SearchBeers", derivedFrom(ctx), &domain.SearchBeersParams{Query: "westmale trip", PageSize: domain.DefaultPageSize}).
+		Return(&domain.SearchBeersResult{
+			Results: []*domain.SearchResult{
+				{Beer: &domain.Beer{ID: "id1", Name: "Westmalle Tripel", Brewer: "Westmalle"}, Score: 0.9},
+				{Beer: &domain.Beer{ID: "id2", Name: "Tripel Karmeliet", Brewer: "Bosteels"}, Score: 0.4},
+			},
+			Next: &domain.Cursor{ID: "id2", Score: 0.4},
+		}, nil)
+	actual, err := interactor.SearchBeers(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.SearchBeersResult{
+		Results: []*domain.SearchResult{
+			{
+				Beer:  &domain.Beer{ID: "id1", Name: "Westmalle Tripel", Brewer: "Westmalle"},
+				Score: 0.9,
+				Snippets: []*domain.Snippet{
+					{Field: "name", Text: "<b>Westmalle</b> <b>Tripel</b>"},
+					{Field: "brewer", Text: "<b>Westmalle</b>"},
+				},
+			},
+			{
+				Beer:     &domain.Beer{ID: "id2", Name: "Tripel Karmeliet", Brewer: "Bosteels"},
+				Score:    0.4,
+				Snippets: []*domain.Snippet{{Field: "name", Text: "<b>Tripel</b> Karmeliet"}},
+			},
+		},
+		Next: &domain.Cursor{ID: "id2", Score: 0.4},
+	}, actual)
+}
+
+func TestSearchBeers_CoercesPageSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		pageSize int
+		expected int
+	}{
+		{
+			name:     "default page size",
+			pageSize: 0,
+			expected: domain.DefaultPageSize,
+		},
+		{
+			name:     "page size above maximum",
+			pageSize: domain.MaxPageSize + 1,
+			expected: domain.MaxPageSize,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("test %s", test.name), func(s *testing.T) {
+			interactor, repo, _, _ := newBeerInteractor()
+			ctx := newContext()
+			repo.On("SearchBeers", derivedFrom(ctx), &domain.SearchBeersParams{Query: "orval", PageSize: test.expected}).
+				Return(&domain.SearchBeersResult{}, nil)
+			_, err := interactor.SearchBeers(ctx, &domain.SearchBeersParams{Query: "orval", PageSize: test.pageSize})
+			assert.Nil(s, err)
+			repo.AssertExpectations(s)
+		})
+	}
+}
+
+func TestListBeerHistory_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	_, err := interactor.ListBeerHistory(context.Background(), &domain.ListBeerHistoryParams{})
+	assert.Equal(t, domain.NewValidationError("beer ID is empty"), err)
+}
+
+func TestListBeerHistory_WhenListAuditEntriesReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, audit, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.ListBeerHistoryParams{ID: "ID"}
+	expected := errors.New("something went wrong")
+	audit.On("ListAuditEntries", derivedFrom(ctx), params).Return(nil, expected)
+	_, actual := interactor.ListBeerHistory(ctx, params)
+	assert.Equal(t, expected, actual)
+}
+
+func TestListBeerHistory_WhenListAuditEntriesReturnsEntries_ReturnsEntries(t *testing.T) {
+	t.Parallel()
+	interactor, _, audit, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.ListBeerHistoryParams{ID: "ID"}
+	expected := []*domain.AuditEntry{{ID: "entry", BeerID: "ID", Action: domain.AuditActionCreate}}
+	audit.On("ListAuditEntries", derivedFrom(ctx), params).Return(expected, nil)
+	actual, err := interactor.ListBeerHistory(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestWatchBeers_WatchesEventBroker(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, events := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.WatchBeersParams{ResumeToken: "token"}
+	event := &domain.BeerEvent{Type: domain.BeerCreated, Beer: &domain.Beer{ID: "ID"}, ResumeToken: "next"}
+	expected := errors.New("something went wrong")
+	events.On("Watch", derivedFrom(ctx), params, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(func(*domain.BeerEvent) error)
+			assert.Equal(t, expected, handler(event))
+		}).
+		Return(expected)
+
+	var actual []*domain.BeerEvent
+	err := interactor.WatchBeers(ctx, params, func(event *domain.BeerEvent) error {
+		actual = append(actual, event)
+		return expected
+	})
+	assert.Equal(t, expected, err)
+	assert.Equal(t, []*domain.BeerEvent{event}, actual)
+}
+
+func TestExportBeers_ExportsRepository(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	params := &domain.ExportBeersParams{ShowDeleted: true}
+	beer := &domain.Beer{ID: "ID"}
+	expected := errors.New("something went wrong")
+	repo.On("ExportBeers", derivedFrom(ctx), params, mock.Anything).
+		Run(func(args mock.Arguments) {
+			handler := args.Get(2).(func(*domain.Beer) error)
+			assert.Equal(t, expected, handler(beer))
+		}).
+		Return(expected)
+
+	var actual []*domain.Beer
+	err := interactor.ExportBeers(ctx, params, func(beer *domain.Beer) error {
+		actual = append(actual, beer)
+		return expected
+	})
+	assert.Equal(t, expected, err)
+	assert.Equal(t, []*domain.Beer{beer}, actual)
+}
+
+func TestImportBeers_WhenValidateReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, _, _, _ := newBeerInteractor()
+	params := &domain.ImportBeersParams{Beers: make([]*domain.Beer, domain.ImportBatchSize+1)}
+	_, err := interactor.ImportBeers(context.Background(), params)
+	assert.Equal(t, domain.NewValidationError("more than 100 beers in batch"), err)
+}
+
+func TestImportBeers_WhenImportBeersReturnsError_ReturnsError(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	ctx := newContext()
+	beers := []*domain.Beer{{ID: "ID", Name: "a beer", Type: domain.Ale}}
+	expected := errors.New("something went wrong")
+	repo.On("ImportBeers", derivedFrom(ctx), beers, recordedBy("", "")).Return(nil, expected)
+	_, actual := interactor.ImportBeers(ctx, &domain.ImportBeersParams{Beers: beers})
+	assert.Equal(t, expected, actual)
+}
+
+func TestImportBeers_WhenBeersInvalid_ReturnsErrors(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, _ := newBeerInteractor()
+	params := &domain.ImportBeersParams{Beers: []*domain.Beer{
+		{ID: "a", Type: domain.Ale},
+		{ID: "b", Name: "b beer"},
+	}}
+	actual, err := interactor.ImportBeers(newContext(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.ImportBeersResult{
+		Failed: 2,
+		Errors: []domain.ImportError{
+			{Index: 0, ID: "a", Message: "beer name is empty"},
+			{Index: 1, ID: "b", Message: "invalid beer type"},
+		},
+	}, actual)
+	repo.AssertNotCalled(t, "ImportBeers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestImportBeers_WhenImportBeersReturnsBeers_PublishesCreatedBeers(t *testing.T) {
+	t.Parallel()
+	interactor, repo, _, events := newBeerInteractor()
+	ctx := domain.NewContextWithRequestID(newContext(), "request")
+	valid := []*domain.Beer{
+		{ID: "a", Name: "a beer", Type: domain.Ale},
+		{ID: "c", Name: "c beer", Type: domain.Stout},
+	}
+	params := &domain.ImportBeersParams{Beers: []*domain.Beer{valid[0], {ID: "b"}, valid[1]}}
+	created := &domain.Beer{ID: "a", Name: "a beer", Type: domain.Ale, Version: 1}
+	repo.On("ImportBeers", derivedFrom(ctx), valid, recordedBy("", "request")).Return([]*domain.Beer{created}, nil)
+	events.On("Publish", derivedFrom(ctx), publishes(domain.BeerCreated, created)).Return()
+	actual, err := interactor.ImportBeers(ctx, params)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.ImportBeersResult{
+		Created: 1,
+		Skipped: 1,
+		Failed:  1,
+		Errors:  []domain.ImportError{{Index: 1, ID: "b", Message: "beer name is empty"}},
+	}, actual)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
@@ -2,20 +2,54 @@ package usecases
 
 import (
 	"context"
+	"time"
 
 	"github.com/bvwells/grpc-gateway-example/pkg/domain"
 )
 
 // BeerRepository is a repository for beers.
 type BeerRepository interface {
-	// CreateBeer creates a beer.
-	CreateBeer(ctx context.Context, params *domain.CreateBeerParams) (*domain.Beer, error)
+	// CreateBeer creates a beer and records its audit entry in the same
+	// transaction.
+	CreateBeer(ctx context.Context, params *domain.CreateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error)
 	// GetBeer gets a beer.
 	GetBeer(ctx context.Context, params *domain.GetBeerParams) (*domain.Beer, error)
-	// UpdateBeer updates a beer.
-	UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error)
-	// DeleteBeer deletes a beer.
-	DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams) error
-	// ListBeers lists beers.
-	ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error)
+	// UpdateBeer updates a beer and records its audit entry in the same
+	// transaction.
+	UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error)
+	// DeleteBeer marks a beer as deleted at the time of the audit record,
+	// records its audit entry in the same transaction and returns the
+	// deleted beer.
+	DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error)
+	// UndeleteBeer undeletes a deleted beer and records its audit entry in
+	// the same transaction.
+	UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error)
+	// PurgeBeers permanently removes the beers deleted before the given time
+	// and returns the number of beers removed.
+	PurgeBeers(ctx context.Context, deletedBefore time.Time) (int, error)
+	// BatchGetBeers gets the beers in the order of their IDs in the params,
+	// failing if any of the beers does not exist.
+	BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error)
+	// BatchCreateBeers creates the beers in a single transaction, in the
+	// order of the params, and records their audit entries in the same
+	// transaction.
+	BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error)
+	// BatchDeleteBeers marks the beers as deleted at the time of the audit
+	// record in a single transaction, deleting none of the beers if any of
+	// the beers cannot be deleted, records their audit entries in the same
+	// transaction and returns the deleted beers in the order of the params.
+	BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error)
+	// ListBeers lists a page of beers.
+	ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error)
+	// SearchBeers returns a page of the beers which are not deleted matching
+	// the search, in order of descending score.
+	SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error)
+	// ExportBeers calls the handler with every beer in ID order, as of a
+	// consistent snapshot of the beers, until the handler returns an error.
+	ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error
+	// ImportBeers creates the beers, with their IDs and versions of one,
+	// which do not already exist in a single transaction, recording their
+	// audit entries in the same transaction, and returns the beers created.
+	// Beers without an ID are given one.
+	ImportBeers(ctx context.Context, beers []*domain.Beer, audit *domain.AuditRecord) ([]*domain.Beer, error)
 }
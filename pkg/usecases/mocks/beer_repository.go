@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/bvwells/grpc-gateway-example/pkg/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // BeerRepository is an autogenerated mock type for the BeerRepository type
@@ -14,21 +16,67 @@ type BeerRepository struct {
 	mock.Mock
 }
 
-// CreateBeer provides a mock function with given fields: ctx, params
-func (_m *BeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams) (*domain.Beer, error) {
+// BatchCreateBeers provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) BatchCreateBeers(ctx context.Context, params *domain.BatchCreateBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchCreateBeersParams, *domain.AuditRecord) []*domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchCreateBeersParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDeleteBeers provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) BatchDeleteBeers(ctx context.Context, params *domain.BatchDeleteBeersParams, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchDeleteBeersParams, *domain.AuditRecord) []*domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchDeleteBeersParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchGetBeers provides a mock function with given fields: ctx, params
+func (_m *BeerRepository) BatchGetBeers(ctx context.Context, params *domain.BatchGetBeersParams) ([]*domain.Beer, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 *domain.Beer
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateBeerParams) *domain.Beer); ok {
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchGetBeersParams) []*domain.Beer); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Beer)
+			r0 = ret.Get(0).([]*domain.Beer)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateBeerParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchGetBeersParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -37,13 +85,59 @@ func (_m *BeerRepository) CreateBeer(ctx context.Context, params *domain.CreateB
 	return r0, r1
 }
 
-// DeleteBeer provides a mock function with given fields: ctx, params
-func (_m *BeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams) error {
-	ret := _m.Called(ctx, params)
+// CreateBeer provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) CreateBeer(ctx context.Context, params *domain.CreateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 *domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateBeerParams, *domain.AuditRecord) *domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateBeerParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBeer provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) DeleteBeer(ctx context.Context, params *domain.DeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 *domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteBeerParams, *domain.AuditRecord) *domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.DeleteBeerParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportBeers provides a mock function with given fields: ctx, params, handler
+func (_m *BeerRepository) ExportBeers(ctx context.Context, params *domain.ExportBeersParams, handler func(*domain.Beer) error) error {
+	ret := _m.Called(ctx, params, handler)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.DeleteBeerParams) error); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ExportBeersParams, func(*domain.Beer) error) error); ok {
+		r0 = rf(ctx, params, handler)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -74,16 +168,39 @@ func (_m *BeerRepository) GetBeer(ctx context.Context, params *domain.GetBeerPar
 	return r0, r1
 }
 
+// ImportBeers provides a mock function with given fields: ctx, beers, audit
+func (_m *BeerRepository) ImportBeers(ctx context.Context, beers []*domain.Beer, audit *domain.AuditRecord) ([]*domain.Beer, error) {
+	ret := _m.Called(ctx, beers, audit)
+
+	var r0 []*domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Beer, *domain.AuditRecord) []*domain.Beer); ok {
+		r0 = rf(ctx, beers, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Beer, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, beers, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBeers provides a mock function with given fields: ctx, params
-func (_m *BeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) ([]*domain.Beer, error) {
+func (_m *BeerRepository) ListBeers(ctx context.Context, params *domain.ListBeersParams) (*domain.ListBeersResult, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 []*domain.Beer
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListBeersParams) []*domain.Beer); ok {
+	var r0 *domain.ListBeersResult
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ListBeersParams) *domain.ListBeersResult); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.Beer)
+			r0 = ret.Get(0).(*domain.ListBeersResult)
 		}
 	}
 
@@ -97,21 +214,42 @@ func (_m *BeerRepository) ListBeers(ctx context.Context, params *domain.ListBeer
 	return r0, r1
 }
 
-// UpdateBeer provides a mock function with given fields: ctx, params
-func (_m *BeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams) (*domain.Beer, error) {
+// PurgeBeers provides a mock function with given fields: ctx, deletedBefore
+func (_m *BeerRepository) PurgeBeers(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchBeers provides a mock function with given fields: ctx, params
+func (_m *BeerRepository) SearchBeers(ctx context.Context, params *domain.SearchBeersParams) (*domain.SearchBeersResult, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 *domain.Beer
-	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateBeerParams) *domain.Beer); ok {
+	var r0 *domain.SearchBeersResult
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.SearchBeersParams) *domain.SearchBeersResult); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Beer)
+			r0 = ret.Get(0).(*domain.SearchBeersResult)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *domain.UpdateBeerParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.SearchBeersParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -119,3 +257,49 @@ func (_m *BeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateB
 
 	return r0, r1
 }
+
+// UndeleteBeer provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) UndeleteBeer(ctx context.Context, params *domain.UndeleteBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 *domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UndeleteBeerParams, *domain.AuditRecord) *domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.UndeleteBeerParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBeer provides a mock function with given fields: ctx, params, audit
+func (_m *BeerRepository) UpdateBeer(ctx context.Context, params *domain.UpdateBeerParams, audit *domain.AuditRecord) (*domain.Beer, error) {
+	ret := _m.Called(ctx, params, audit)
+
+	var r0 *domain.Beer
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.UpdateBeerParams, *domain.AuditRecord) *domain.Beer); ok {
+		r0 = rf(ctx, params, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Beer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.UpdateBeerParams, *domain.AuditRecord) error); ok {
+		r1 = rf(ctx, params, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
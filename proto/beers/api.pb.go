@@ -10,6 +10,7 @@ import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
@@ -100,16 +101,130 @@ func (BeerType) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{0}
 }
 
+type BeerEventType int32
+
+const (
+	BeerEventType_BEER_EVENT_TYPE_UNSPECIFIED BeerEventType = 0
+	BeerEventType_BEER_EVENT_TYPE_CREATED     BeerEventType = 1
+	BeerEventType_BEER_EVENT_TYPE_UPDATED     BeerEventType = 2
+	BeerEventType_BEER_EVENT_TYPE_DELETED     BeerEventType = 3
+)
+
+// Enum value maps for BeerEventType.
+var (
+	BeerEventType_name = map[int32]string{
+		0: "BEER_EVENT_TYPE_UNSPECIFIED",
+		1: "BEER_EVENT_TYPE_CREATED",
+		2: "BEER_EVENT_TYPE_UPDATED",
+		3: "BEER_EVENT_TYPE_DELETED",
+	}
+	BeerEventType_value = map[string]int32{
+		"BEER_EVENT_TYPE_UNSPECIFIED": 0,
+		"BEER_EVENT_TYPE_CREATED":     1,
+		"BEER_EVENT_TYPE_UPDATED":     2,
+		"BEER_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x BeerEventType) Enum() *BeerEventType {
+	p := new(BeerEventType)
+	*p = x
+	return p
+}
+
+func (x BeerEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BeerEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[1].Descriptor()
+}
+
+func (BeerEventType) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[1]
+}
+
+func (x BeerEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BeerEventType.Descriptor instead.
+func (BeerEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
+type AuditAction int32
+
+const (
+	AuditAction_AUDIT_ACTION_UNSPECIFIED AuditAction = 0
+	AuditAction_AUDIT_ACTION_CREATE      AuditAction = 1
+	AuditAction_AUDIT_ACTION_UPDATE      AuditAction = 2
+	AuditAction_AUDIT_ACTION_DELETE      AuditAction = 3
+	AuditAction_AUDIT_ACTION_UNDELETE    AuditAction = 4
+)
+
+// Enum value maps for AuditAction.
+var (
+	AuditAction_name = map[int32]string{
+		0: "AUDIT_ACTION_UNSPECIFIED",
+		1: "AUDIT_ACTION_CREATE",
+		2: "AUDIT_ACTION_UPDATE",
+		3: "AUDIT_ACTION_DELETE",
+		4: "AUDIT_ACTION_UNDELETE",
+	}
+	AuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED": 0,
+		"AUDIT_ACTION_CREATE":      1,
+		"AUDIT_ACTION_UPDATE":      2,
+		"AUDIT_ACTION_DELETE":      3,
+		"AUDIT_ACTION_UNDELETE":    4,
+	}
+)
+
+func (x AuditAction) Enum() *AuditAction {
+	p := new(AuditAction)
+	*p = x
+	return p
+}
+
+func (x AuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[2].Descriptor()
+}
+
+func (AuditAction) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[2]
+}
+
+func (x AuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditAction.Descriptor instead.
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{2}
+}
+
 type Beer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type    BeerType `protobuf:"varint,3,opt,name=type,proto3,enum=BeerType" json:"type,omitempty"`
-	Brewer  string   `protobuf:"bytes,4,opt,name=brewer,proto3" json:"brewer,omitempty"`
-	Country string   `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Id          string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        BeerType             `protobuf:"varint,3,opt,name=type,proto3,enum=BeerType" json:"type,omitempty"`
+	Brewer      string               `protobuf:"bytes,4,opt,name=brewer,proto3" json:"brewer,omitempty"`
+	Country     string               `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Etag        string               `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	DeleteTime  *timestamp.Timestamp `protobuf:"bytes,7,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	Abv         float64              `protobuf:"fixed64,8,opt,name=abv,proto3" json:"abv,omitempty"`
+	Ibu         int32                `protobuf:"varint,9,opt,name=ibu,proto3" json:"ibu,omitempty"`
+	Srm         float64              `protobuf:"fixed64,10,opt,name=srm,proto3" json:"srm,omitempty"`
+	VolumeMl    int32                `protobuf:"varint,11,opt,name=volume_ml,json=volumeMl,proto3" json:"volume_ml,omitempty"`
+	Description string               `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (x *Beer) Reset() {
@@ -179,15 +294,69 @@ func (x *Beer) GetCountry() string {
 	return ""
 }
 
+func (x *Beer) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Beer) GetDeleteTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+func (x *Beer) GetAbv() float64 {
+	if x != nil {
+		return x.Abv
+	}
+	return 0
+}
+
+func (x *Beer) GetIbu() int32 {
+	if x != nil {
+		return x.Ibu
+	}
+	return 0
+}
+
+func (x *Beer) GetSrm() float64 {
+	if x != nil {
+		return x.Srm
+	}
+	return 0
+}
+
+func (x *Beer) GetVolumeMl() int32 {
+	if x != nil {
+		return x.VolumeMl
+	}
+	return 0
+}
+
+func (x *Beer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type CreateBeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type    BeerType `protobuf:"varint,2,opt,name=type,proto3,enum=BeerType" json:"type,omitempty"`
-	Brewer  string   `protobuf:"bytes,3,opt,name=brewer,proto3" json:"brewer,omitempty"`
-	Country string   `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        BeerType `protobuf:"varint,2,opt,name=type,proto3,enum=BeerType" json:"type,omitempty"`
+	Brewer      string   `protobuf:"bytes,3,opt,name=brewer,proto3" json:"brewer,omitempty"`
+	Country     string   `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Abv         float64  `protobuf:"fixed64,5,opt,name=abv,proto3" json:"abv,omitempty"`
+	Ibu         int32    `protobuf:"varint,6,opt,name=ibu,proto3" json:"ibu,omitempty"`
+	Srm         float64  `protobuf:"fixed64,7,opt,name=srm,proto3" json:"srm,omitempty"`
+	VolumeMl    int32    `protobuf:"varint,8,opt,name=volume_ml,json=volumeMl,proto3" json:"volume_ml,omitempty"`
+	Description string   `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (x *CreateBeerRequest) Reset() {
@@ -250,12 +419,48 @@ func (x *CreateBeerRequest) GetCountry() string {
 	return ""
 }
 
+func (x *CreateBeerRequest) GetAbv() float64 {
+	if x != nil {
+		return x.Abv
+	}
+	return 0
+}
+
+func (x *CreateBeerRequest) GetIbu() int32 {
+	if x != nil {
+		return x.Ibu
+	}
+	return 0
+}
+
+func (x *CreateBeerRequest) GetSrm() float64 {
+	if x != nil {
+		return x.Srm
+	}
+	return 0
+}
+
+func (x *CreateBeerRequest) GetVolumeMl() int32 {
+	if x != nil {
+		return x.VolumeMl
+	}
+	return 0
+}
+
+func (x *CreateBeerRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type GetBeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShowDeleted bool   `protobuf:"varint,2,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *GetBeerRequest) Reset() {
@@ -297,6 +502,13 @@ func (x *GetBeerRequest) GetId() string {
 	return ""
 }
 
+func (x *GetBeerRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type UpdateBeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -306,6 +518,10 @@ type UpdateBeerRequest struct {
 	// Indicates which fields in the provided beer to update. Must be
 	// specified and non-empty.
 	UpdateMask *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The etag of the beer. If specified, the beer is only updated if it has
+	// not been updated since the etag was returned. The etag of the beer, then
+	// the If-Match header, is used if not specified.
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *UpdateBeerRequest) Reset() {
@@ -354,12 +570,20 @@ func (x *UpdateBeerRequest) GetUpdateMask() *field_mask.FieldMask {
 	return nil
 }
 
+func (x *UpdateBeerRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type DeleteBeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *DeleteBeerRequest) Reset() {
@@ -401,18 +625,87 @@ func (x *DeleteBeerRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteBeerRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UndeleteBeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+}
+
+func (x *UndeleteBeerRequest) Reset() {
+	*x = UndeleteBeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteBeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteBeerRequest) ProtoMessage() {}
+
+func (x *UndeleteBeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteBeerRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteBeerRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UndeleteBeerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UndeleteBeerRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 type ListBeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Type        BeerType `protobuf:"varint,2,opt,name=type,proto3,enum=BeerType" json:"type,omitempty"`
+	Brewer      string   `protobuf:"bytes,3,opt,name=brewer,proto3" json:"brewer,omitempty"`
+	Country     string   `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	NamePrefix  string   `protobuf:"bytes,5,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	OrderBy     string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	PageSize    int32    `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string   `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ShowDeleted bool     `protobuf:"varint,9,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
 func (x *ListBeersRequest) Reset() {
 	*x = ListBeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[5]
+		mi := &file_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +718,7 @@ func (x *ListBeersRequest) String() string {
 func (*ListBeersRequest) ProtoMessage() {}
 
 func (x *ListBeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[5]
+	mi := &file_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,28 +731,79 @@ func (x *ListBeersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBeersRequest.ProtoReflect.Descriptor instead.
 func (*ListBeersRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListBeersRequest) GetType() BeerType {
+	if x != nil {
+		return x.Type
+	}
+	return BeerType_BEER_TYPE_UNSPECIFIED
+}
+
+func (x *ListBeersRequest) GetBrewer() string {
+	if x != nil {
+		return x.Brewer
+	}
+	return ""
+}
+
+func (x *ListBeersRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListBeersRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListBeersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
 }
 
-func (x *ListBeersRequest) GetPage() int32 {
+func (x *ListBeersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Page
+		return x.PageSize
 	}
 	return 0
 }
 
+func (x *ListBeersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBeersRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
 type ListBeersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Beers []*Beer `protobuf:"bytes,1,rep,name=beers,proto3" json:"beers,omitempty"`
+	Beers         []*Beer `protobuf:"bytes,1,rep,name=beers,proto3" json:"beers,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
 func (x *ListBeersResponse) Reset() {
 	*x = ListBeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[6]
+		mi := &file_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -472,7 +816,7 @@ func (x *ListBeersResponse) String() string {
 func (*ListBeersResponse) ProtoMessage() {}
 
 func (x *ListBeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[6]
+	mi := &file_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +829,7 @@ func (x *ListBeersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBeersResponse.ProtoReflect.Descriptor instead.
 func (*ListBeersResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListBeersResponse) GetBeers() []*Beer {
@@ -495,32 +839,47 @@ func (x *ListBeersResponse) GetBeers() []*Beer {
 	return nil
 }
 
-type Error struct {
+func (x *ListBeersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListBeersResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type SearchBeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Q         string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
+func (x *SearchBeersRequest) Reset() {
+	*x = SearchBeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[7]
+		mi := &file_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Error) String() string {
+func (x *SearchBeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*SearchBeersRequest) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[7]
+func (x *SearchBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,26 +890,1431 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SearchBeersRequest.ProtoReflect.Descriptor instead.
+func (*SearchBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Error) GetCode() int32 {
+func (x *SearchBeersRequest) GetQ() string {
 	if x != nil {
-		return x.Code
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchBeersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *Error) GetMessage() string {
+func (x *SearchBeersRequest) GetPageToken() string {
 	if x != nil {
-		return x.Message
+		return x.PageToken
 	}
 	return ""
 }
 
-var File_api_proto protoreflect.FileDescriptor
+type Snippet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Text  string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Snippet) Reset() {
+	*x = Snippet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snippet) ProtoMessage() {}
+
+func (x *Snippet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snippet.ProtoReflect.Descriptor instead.
+func (*Snippet) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Snippet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Snippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beer     *Beer      `protobuf:"bytes,1,opt,name=beer,proto3" json:"beer,omitempty"`
+	Score    float64    `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Snippets []*Snippet `protobuf:"bytes,3,rep,name=snippets,proto3" json:"snippets,omitempty"`
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchResult) GetBeer() *Beer {
+	if x != nil {
+		return x.Beer
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSnippets() []*Snippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+type SearchBeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchBeersResponse) Reset() {
+	*x = SearchBeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBeersResponse) ProtoMessage() {}
+
+func (x *SearchBeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBeersResponse.ProtoReflect.Descriptor instead.
+func (*SearchBeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchBeersResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchBeersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchGetBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetBeersRequest) Reset() {
+	*x = BatchGetBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBeersRequest) ProtoMessage() {}
+
+func (x *BatchGetBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBeersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetBeersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetBeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beers []*Beer `protobuf:"bytes,1,rep,name=beers,proto3" json:"beers,omitempty"`
+}
+
+func (x *BatchGetBeersResponse) Reset() {
+	*x = BatchGetBeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetBeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBeersResponse) ProtoMessage() {}
+
+func (x *BatchGetBeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBeersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetBeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetBeersResponse) GetBeers() []*Beer {
+	if x != nil {
+		return x.Beers
+	}
+	return nil
+}
+
+type BatchCreateBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CreateBeerRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchCreateBeersRequest) Reset() {
+	*x = BatchCreateBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateBeersRequest) ProtoMessage() {}
+
+func (x *BatchCreateBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateBeersRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchCreateBeersRequest) GetRequests() []*CreateBeerRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchCreateBeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beers []*Beer `protobuf:"bytes,1,rep,name=beers,proto3" json:"beers,omitempty"`
+}
+
+func (x *BatchCreateBeersResponse) Reset() {
+	*x = BatchCreateBeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateBeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateBeersResponse) ProtoMessage() {}
+
+func (x *BatchCreateBeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateBeersResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateBeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchCreateBeersResponse) GetBeers() []*Beer {
+	if x != nil {
+		return x.Beers
+	}
+	return nil
+}
+
+type BatchDeleteBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*DeleteBeerRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchDeleteBeersRequest) Reset() {
+	*x = BatchDeleteBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteBeersRequest) ProtoMessage() {}
+
+func (x *BatchDeleteBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteBeersRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchDeleteBeersRequest) GetRequests() []*DeleteBeerRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type WatchBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchBeersRequest) Reset() {
+	*x = WatchBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBeersRequest) ProtoMessage() {}
+
+func (x *WatchBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBeersRequest.ProtoReflect.Descriptor instead.
+func (*WatchBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchBeersRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type BeerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        BeerEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=BeerEventType" json:"type,omitempty"`
+	Beer        *Beer                `protobuf:"bytes,2,opt,name=beer,proto3" json:"beer,omitempty"`
+	CreateTime  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ResumeToken string               `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *BeerEvent) Reset() {
+	*x = BeerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeerEvent) ProtoMessage() {}
+
+func (x *BeerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeerEvent.ProtoReflect.Descriptor instead.
+func (*BeerEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BeerEvent) GetType() BeerEventType {
+	if x != nil {
+		return x.Type
+	}
+	return BeerEventType_BEER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *BeerEvent) GetBeer() *Beer {
+	if x != nil {
+		return x.Beer
+	}
+	return nil
+}
+
+func (x *BeerEvent) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *BeerEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ExportBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShowDeleted bool `protobuf:"varint,1,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+}
+
+func (x *ExportBeersRequest) Reset() {
+	*x = ExportBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBeersRequest) ProtoMessage() {}
+
+func (x *ExportBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBeersRequest.ProtoReflect.Descriptor instead.
+func (*ExportBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportBeersRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+type ImportBeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beers []*Beer `protobuf:"bytes,1,rep,name=beers,proto3" json:"beers,omitempty"`
+}
+
+func (x *ImportBeersRequest) Reset() {
+	*x = ImportBeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBeersRequest) ProtoMessage() {}
+
+func (x *ImportBeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBeersRequest.ProtoReflect.Descriptor instead.
+func (*ImportBeersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportBeersRequest) GetBeers() []*Beer {
+	if x != nil {
+		return x.Beers
+	}
+	return nil
+}
+
+type ImportError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportError) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportBeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedCount int32          `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	SkippedCount int32          `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailedCount  int32          `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Errors       []*ImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ImportBeersResponse) Reset() {
+	*x = ImportBeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBeersResponse) ProtoMessage() {}
+
+func (x *ImportBeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBeersResponse.ProtoReflect.Descriptor instead.
+func (*ImportBeersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportBeersResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportBeersResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportBeersResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportBeersResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type BeerAuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BeerId     string               `protobuf:"bytes,2,opt,name=beer_id,json=beerId,proto3" json:"beer_id,omitempty"`
+	Action     AuditAction          `protobuf:"varint,3,opt,name=action,proto3,enum=AuditAction" json:"action,omitempty"`
+	Actor      string               `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId  string               `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Changes    []*FieldChange       `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *BeerAuditEntry) Reset() {
+	*x = BeerAuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeerAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeerAuditEntry) ProtoMessage() {}
+
+func (x *BeerAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeerAuditEntry.ProtoReflect.Descriptor instead.
+func (*BeerAuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BeerAuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BeerAuditEntry) GetBeerId() string {
+	if x != nil {
+		return x.BeerId
+	}
+	return ""
+}
+
+func (x *BeerAuditEntry) GetAction() AuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return AuditAction_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *BeerAuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BeerAuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *BeerAuditEntry) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BeerAuditEntry) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListBeerHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListBeerHistoryRequest) Reset() {
+	*x = ListBeerHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeerHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeerHistoryRequest) ProtoMessage() {}
+
+func (x *ListBeerHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeerHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListBeerHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListBeerHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBeerHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*BeerAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListBeerHistoryResponse) Reset() {
+	*x = ListBeerHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBeerHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBeerHistoryResponse) ProtoMessage() {}
+
+func (x *ListBeerHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBeerHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListBeerHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListBeerHistoryResponse) GetEntries() []*BeerAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes     []string             `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreateTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	RevokeTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpireTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokeTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes     []string             `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpireTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key    string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{30}
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_api_proto protoreflect.FileDescriptor
 
 var file_api_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
@@ -559,156 +2323,1004 @@ var file_api_proto_rawDesc = []byte{
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x02, 0x0a, 0x04, 0x42, 0x65, 0x65, 0x72, 0x12,
-	0x37, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24,
-	0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
-	0x65, 0x65, 0x72, 0x2e, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65,
-	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65,
-	0x72, 0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65,
-	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0x54, 0x68, 0x65, 0x20, 0x62, 0x72,
-	0x65, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
-	0x2e, 0x52, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32,
-	0x25, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x74, 0x68, 0x65,
-	0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
-	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x2e, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x3a,
-	0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0x2a, 0x04, 0x42, 0x65, 0x65, 0x72, 0x32, 0x17, 0x41, 0x20,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
-	0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0xb9, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65, 0x20,
-	0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
-	0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20,
-	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0x54, 0x68, 0x65, 0x20, 0x62, 0x72, 0x65,
-	0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e,
-	0x52, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x25,
-	0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20,
-	0x62, 0x65, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20,
-	0x66, 0x72, 0x6f, 0x6d, 0x2e, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x3a, 0x3d,
-	0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61,
-	0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x74, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16,
-	0x32, 0x0f, 0x42, 0x65, 0x65, 0x72, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a,
-	0x32, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x32, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x67,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01,
-	0x02, 0x69, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x04, 0x62, 0x65, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x52, 0x04,
-	0x62, 0x65, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
-	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
-	0x6b, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b, 0x2a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x07, 0x62, 0x65, 0x65, 0x72,
-	0x2e, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x09, 0x0a, 0x04, 0x42, 0x65, 0x65, 0x72,
+	0x12, 0x37, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41,
+	0x24, 0x32, 0x22, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68,
+	0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65,
+	0x65, 0x72, 0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65, 0x20, 0x74, 0x79, 0x70,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0x54, 0x68, 0x65, 0x20, 0x62,
+	0x72, 0x65, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65,
+	0x72, 0x2e, 0x52, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27,
+	0x32, 0x25, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x2e, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x86, 0x01, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x72, 0x92, 0x41, 0x6f, 0x32, 0x6b, 0x54, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x20, 0x74, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x2c, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
+	0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x20,
+	0x41, 0x6c, 0x73, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x45, 0x54, 0x61, 0x67, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x40, 0x01, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0xae, 0x01, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x71, 0x92, 0x41, 0x6e,
+	0x32, 0x6a, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x77, 0x61, 0x73, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x2c,
+	0x20, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x2e, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x75, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x79,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x2e, 0x40, 0x01, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x03, 0x61, 0x62,
+	0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x58, 0x92, 0x41, 0x55, 0x32, 0x53, 0x54, 0x68,
+	0x65, 0x20, 0x61, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x20, 0x62, 0x79, 0x20, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20,
+	0x69, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x2e, 0x20, 0x5a,
+	0x65, 0x72, 0x6f, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x2e, 0x52, 0x03, 0x61, 0x62, 0x76, 0x12, 0x7b, 0x0a, 0x03, 0x69, 0x62, 0x75, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x69, 0x92, 0x41, 0x66, 0x32, 0x64, 0x54, 0x68, 0x65, 0x20, 0x62, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x62, 0x69, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x73, 0x73,
+	0x20, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x30, 0x2e, 0x20, 0x5a, 0x65, 0x72, 0x6f,
+	0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x52, 0x03,
+	0x69, 0x62, 0x75, 0x12, 0xab, 0x01, 0x0a, 0x03, 0x73, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x98, 0x01, 0x92, 0x41, 0x94, 0x01, 0x32, 0x91, 0x01, 0x54, 0x68, 0x65, 0x20, 0x63,
+	0x6f, 0x6c, 0x6f, 0x75, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65,
+	0x72, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72,
+	0x64, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x20, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x2e, 0x20, 0x54, 0x68, 0x65,
+	0x20, 0x45, 0x42, 0x43, 0x20, 0x63, 0x6f, 0x6c, 0x6f, 0x75, 0x72, 0x20, 0x69, 0x73, 0x20, 0x31,
+	0x2e, 0x39, 0x37, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x53, 0x52,
+	0x4d, 0x20, 0x63, 0x6f, 0x6c, 0x6f, 0x75, 0x72, 0x2e, 0x20, 0x5a, 0x65, 0x72, 0x6f, 0x20, 0x69,
+	0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x52, 0x03, 0x73, 0x72,
+	0x6d, 0x12, 0x78, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x32, 0x56, 0x54, 0x68, 0x65, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x6c, 0x69, 0x74, 0x72, 0x65, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x30, 0x30, 0x2e, 0x20, 0x5a,
+	0x65, 0x72, 0x6f, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x2e, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x6c, 0x12, 0x5a, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0x41, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x2c, 0x20, 0x61, 0x74, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x32, 0x30, 0x30, 0x30, 0x20, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0x2a, 0x04,
+	0x42, 0x65, 0x65, 0x72, 0x32, 0x17, 0x41, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02,
+	0x69, 0x64, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x06, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92,
+	0x41, 0x17, 0x32, 0x15, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x42, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x54,
+	0x68, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x2e, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x72,
+	0x65, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32,
+	0x17, 0x54, 0x68, 0x65, 0x20, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x25, 0x54, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x2e, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x57, 0x0a, 0x03, 0x61, 0x62, 0x76, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x40, 0x54, 0x68, 0x65, 0x20, 0x61, 0x6c,
+	0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x20, 0x62, 0x79, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20,
+	0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x2e, 0x52, 0x03, 0x61, 0x62, 0x76, 0x12,
+	0x68, 0x0a, 0x03, 0x69, 0x62, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x56, 0x92, 0x41,
+	0x53, 0x32, 0x51, 0x54, 0x68, 0x65, 0x20, 0x62, 0x69, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x73,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x6e,
+	0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x62,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x20, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x2c,
+	0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31,
+	0x30, 0x30, 0x30, 0x2e, 0x52, 0x03, 0x69, 0x62, 0x75, 0x12, 0x68, 0x0a, 0x03, 0x73, 0x72, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x56, 0x92, 0x41, 0x53, 0x32, 0x51, 0x54, 0x68, 0x65,
+	0x20, 0x63, 0x6f, 0x6c, 0x6f, 0x75, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x20, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x20, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x20, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x2e, 0x52, 0x03,
+	0x73, 0x72, 0x6d, 0x12, 0x65, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x6d, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x48, 0x92, 0x41, 0x45, 0x32, 0x43, 0x54, 0x68, 0x65,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x6c, 0x69, 0x74, 0x72, 0x65, 0x73, 0x2c, 0x20, 0x62, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x20, 0x30, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x31, 0x30, 0x30, 0x30, 0x30, 0x2e,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4d, 0x6c, 0x12, 0x5a, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0x41, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c,
+	0x20, 0x61, 0x74, 0x20, 0x6d, 0x6f, 0x73, 0x74, 0x20, 0x32, 0x30, 0x30, 0x30, 0x20, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65,
 	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0f, 0x42, 0x65, 0x65, 0x72, 0x20,
 	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52,
-	0x02, 0x69, 0x64, 0x3a, 0x3b, 0x92, 0x41, 0x38, 0x0a, 0x36, 0x2a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64,
-	0x22, 0x74, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x20, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0xd2, 0x01, 0x04, 0x70, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x3a, 0x33, 0x92, 0x41, 0x30, 0x0a, 0x2e, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1a, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20,
-	0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05,
-	0x62, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65,
-	0x65, 0x72, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x32, 0x0a, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65,
-	0x72, 0x73, 0x2e, 0x52, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a,
-	0x39, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x66,
-	0x72, 0x6f, 0x6d, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x65, 0x65, 0x72,
-	0x73, 0x2e, 0xd2, 0x01, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x05, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x20, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x92,
-	0x41, 0x13, 0x32, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xdb,
-	0x01, 0x0a, 0x08, 0x42, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x42,
-	0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x45,
-	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12,
-	0x13, 0x0a, 0x0f, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x41, 0x47,
-	0x45, 0x52, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x41, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x45,
-	0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x53, 0x54, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x45, 0x45, 0x52, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x49, 0x4c, 0x53, 0x4e, 0x45, 0x52, 0x10, 0x06, 0x12, 0x14,
-	0x0a, 0x10, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x45, 0x52, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x41, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x45, 0x10, 0x08, 0x32, 0xad, 0x0b, 0x0a,
-	0x0b, 0x42, 0x65, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xe3, 0x01, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0xb9, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22,
-	0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x01,
-	0x2a, 0x92, 0x41, 0x9d, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x2a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
-	0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55,
+	0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23,
+	0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x65, 0x76, 0x65,
+	0x6e, 0x20, 0x69, 0x66, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x2e, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x42, 0x65, 0x65, 0x72, 0x52, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x3a, 0x40, 0x92, 0x41, 0x3d,
+	0x0a, 0x3b, 0x2a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65,
+	0x65, 0x72, 0x2e, 0xd2, 0x01, 0x07, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x22, 0xbc, 0x02,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x19, 0x92, 0x41, 0x16, 0x32, 0x0f, 0x42, 0x65, 0x65, 0x72, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0xbe,
+	0x01, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa9, 0x01,
+	0x92, 0x41, 0xa5, 0x01, 0x32, 0xa2, 0x01, 0x54, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x20, 0x49, 0x66, 0x20,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x69, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x77, 0x61,
+	0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20,
+	0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20,
+	0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x3a,
+	0x3b, 0x92, 0x41, 0x38, 0x0a, 0x36, 0x2a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20,
+	0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0xd4, 0x02, 0x0a,
+	0x13, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x0f, 0x42, 0x65, 0x65, 0x72, 0x20, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0xc8, 0x01, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xb3,
+	0x01, 0x92, 0x41, 0xaf, 0x01, 0x32, 0xac, 0x01, 0x54, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20,
+	0x62, 0x65, 0x65, 0x72, 0x2e, 0x20, 0x49, 0x66, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20,
+	0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x69,
+	0x66, 0x20, 0x69, 0x74, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x65,
+	0x6e, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x77, 0x61, 0x73, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x49, 0x66, 0x2d, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73,
+	0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x2e, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x3a, 0x47, 0x92, 0x41, 0x44, 0x0a,
+	0x42, 0x2a, 0x13, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x26, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61,
+	0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01,
+	0x02, 0x69, 0x64, 0x22, 0x87, 0x07, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x43, 0x0a, 0x06, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x2e, 0x52, 0x06, 0x62,
+	0x72, 0x65, 0x77, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0x4f, 0x6e, 0x6c,
+	0x79, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x2e, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x5e, 0x0a,
+	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69,
+	0x73, 0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x2e, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x9e, 0x01,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x82, 0x01, 0x92, 0x41, 0x7f, 0x32, 0x7d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x20, 0x73, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20,
+	0x62, 0x79, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x64,
+	0x65, 0x73, 0x63, 0x2c, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x22, 0x2e, 0x20, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x69, 0x64, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x74, 0x79, 0x70, 0x65,
+	0x2c, 0x20, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x80,
+	0x01, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x63, 0x92, 0x41, 0x60, 0x32, 0x5e, 0x54, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2e, 0x20,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x2c,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x62, 0x6f, 0x76, 0x65, 0x20, 0x31, 0x30,
+	0x30, 0x30, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x65, 0x72, 0x63, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x31, 0x30, 0x30, 0x30, 0x2e, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x96, 0x01, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x77, 0x92, 0x41, 0x74, 0x32, 0x72, 0x41, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x20, 0x41, 0x6c, 0x6c, 0x20, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x6d, 0x75,
+	0x73, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c,
+	0x6c, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x68,
+	0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0x41, 0x6c, 0x73, 0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x52,
+	0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x3a, 0x33, 0x92, 0x41,
+	0x30, 0x0a, 0x2e, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73,
+	0x2e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x02,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x42, 0x0f, 0x92, 0x41, 0x0c, 0x32, 0x0a,
+	0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x05, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x7a, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32,
+	0x4d, 0x41, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61,
+	0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6e, 0x6f, 0x20, 0x6d, 0x6f, 0x72, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x53, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0x54, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01, 0x05, 0x62, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0xe3, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xa7, 0x01, 0x0a, 0x01, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x98, 0x01, 0x92, 0x41, 0x94, 0x01, 0x32, 0x91, 0x01, 0x54,
+	0x68, 0x65, 0x20, 0x74, 0x65, 0x78, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x2c, 0x20, 0x62, 0x72, 0x65,
+	0x77, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x2e,
+	0x20, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x6d, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x70,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e,
+	0x52, 0x01, 0x71, 0x12, 0x80, 0x01, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x63, 0x92, 0x41, 0x60, 0x32, 0x5e, 0x54, 0x68,
+	0x65, 0x20, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x2e, 0x20, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f,
+	0x20, 0x31, 0x30, 0x30, 0x2c, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x62, 0x6f,
+	0x76, 0x65, 0x20, 0x31, 0x30, 0x30, 0x30, 0x20, 0x61, 0x72, 0x65, 0x20, 0x63, 0x6f, 0x65, 0x72,
+	0x63, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x30, 0x2e, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x63, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32,
+	0x3f, 0x41, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3b, 0x92, 0x41, 0x38,
+	0x0a, 0x36, 0x2a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x65,
+	0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01, 0x01, 0x71, 0x22, 0x98, 0x02, 0x0a, 0x07, 0x53, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x12, 0x51, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c,
+	0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x62, 0x72,
+	0x65, 0x77, 0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2e,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x69, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0x92, 0x41, 0x52, 0x32, 0x50, 0x54, 0x68, 0x65, 0x20,
+	0x48, 0x54, 0x4d, 0x4c, 0x20, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x64, 0x20, 0x74, 0x65, 0x78,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x3c,
+	0x62, 0x3e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x3c, 0x2f, 0x62, 0x3e, 0x2e, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x07, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x32, 0x3f, 0x41, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x2e, 0x22, 0x9f, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09,
+	0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12,
+	0x5c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x46,
+	0x92, 0x41, 0x43, 0x32, 0x41, 0x48, 0x6f, 0x77, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2c, 0x20, 0x68, 0x69, 0x67, 0x68, 0x65,
+	0x72, 0x20, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x56, 0x0a,
+	0x08, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x2b,
+	0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2e, 0x52, 0x08, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x73, 0x3a, 0x2e, 0x92, 0x41, 0x2b, 0x0a, 0x29, 0x2a, 0x0c, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x19, 0x41, 0x20, 0x62, 0x65,
+	0x65, 0x72, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x2e, 0x22, 0xb9, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x37, 0x92,
+	0x41, 0x34, 0x32, 0x32, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2c, 0x20, 0x62, 0x65, 0x73, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x7a, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32, 0x4d, 0x41,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f,
+	0x20, 0x6d, 0x6f, 0x72, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x44, 0x92, 0x41, 0x41,
+	0x0a, 0x3f, 0x2a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0x54, 0x68,
+	0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65,
+	0x74, 0x2e, 0x52, 0x03, 0x69, 0x64, 0x73, 0x3a, 0x48, 0x92, 0x41, 0x45, 0x0a, 0x43, 0x2a, 0x14,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x25, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0xc4, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65, 0x65,
+	0x72, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x32, 0x3a, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x2c, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x4d, 0x92, 0x41, 0x4a, 0x0a, 0x48,
+	0x2a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x67, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61,
+	0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e,
+	0xd2, 0x01, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32,
+	0x24, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20,
+	0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x3a,
+	0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
+	0x26, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05,
+	0x2e, 0x42, 0x65, 0x65, 0x72, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x30, 0x54, 0x68, 0x65, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x52, 0x05, 0x62, 0x65,
+	0x65, 0x72, 0x73, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x18, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x28, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2, 0x01,
+	0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x65, 0x92, 0x41, 0x62, 0x32, 0x60,
+	0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61,
+	0x67, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a,
+	0x4c, 0x2a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x26, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x20, 0x61, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x2e, 0xd2, 0x01, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x9e, 0x02,
+	0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0xc3, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x9f, 0x01, 0x92, 0x41, 0x9b,
+	0x01, 0x32, 0x98, 0x01, 0x54, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x2c,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x61, 0x20, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x61, 0x72,
+	0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x6e, 0x6f,
+	0x74, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2e, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x43, 0x92, 0x41, 0x40, 0x0a, 0x3e,
+	0x2a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x29, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x22, 0xc6,
+	0x03, 0x0a, 0x09, 0x42, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x42, 0x65, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32,
+	0x37, 0x54, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x20, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a,
+	0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42,
+	0x65, 0x65, 0x72, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65,
+	0x65, 0x72, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2e, 0x52, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x23, 0x92, 0x41, 0x20,
+	0x32, 0x1e, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x2e,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x2b, 0x41, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x3a, 0x64, 0x92, 0x41, 0x61, 0x0a, 0x5f, 0x2a, 0x09, 0x42, 0x65, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x32, 0x27, 0x41, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0xd2, 0x01, 0x04, 0x62, 0x65, 0x65, 0x72, 0xd2, 0x01, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0xd2, 0x01, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53,
+	0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x2b, 0x57, 0x68, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73,
+	0x20, 0x77, 0x65, 0x6c, 0x6c, 0x2e, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37, 0x2a, 0x12, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x2e, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x05, 0x62, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x42,
+	0x63, 0x92, 0x41, 0x60, 0x32, 0x5e, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x20, 0x42, 0x65, 0x65, 0x72, 0x73,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x31, 0x2e, 0x52, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x42, 0x92, 0x41, 0x3f,
+	0x0a, 0x3d, 0x2a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1f, 0x41, 0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0xd2, 0x01, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x22,
+	0xbc, 0x02, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x50, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x3a,
+	0x92, 0x41, 0x37, 0x32, 0x35, 0x54, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x61, 0x6d, 0x6f, 0x6e, 0x67,
+	0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x4c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92,
+	0x41, 0x39, 0x32, 0x37, 0x54, 0x68, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65,
+	0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x2e, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x42, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0x57, 0x68, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65,
+	0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a, 0x44, 0x2a, 0x0b, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x23, 0x41, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+	0x62, 0x65, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2e, 0xd2, 0x01, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0xd2, 0x01, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfd,
+	0x03, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0x92,
+	0x41, 0x1e, 0x32, 0x1c, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f,
+	0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e,
+	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x78,
+	0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x53, 0x92, 0x41, 0x50, 0x32, 0x4e, 0x54, 0x68, 0x65, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65,
+	0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x61, 0x6c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x2e, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x35,
+	0x92, 0x41, 0x32, 0x32, 0x30, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x42, 0x39, 0x92, 0x41, 0x36, 0x32, 0x34, 0x54, 0x68, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62,
+	0x65, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x3a, 0x69, 0x92, 0x41, 0x66, 0x0a, 0x64, 0x2a, 0x13, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x1e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0xd2,
+	0x01, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xd2,
+	0x01, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xd2,
+	0x01, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc3,
+	0x02, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3e,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92,
+	0x41, 0x25, 0x32, 0x23, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20,
+	0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x56,
+	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e,
+	0x92, 0x41, 0x3b, 0x32, 0x39, 0x54, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2c, 0x20, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x2e, 0x52, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x53, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0x54, 0x68, 0x65, 0x20,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x75, 0x6e,
+	0x73, 0x65, 0x74, 0x2e, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x3a, 0x47, 0x92, 0x41, 0x44,
+	0x0a, 0x42, 0x2a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x32,
+	0x2b, 0x41, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0xdf, 0x05, 0x0a, 0x0e, 0x42, 0x65, 0x65, 0x72, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0x54, 0x68, 0x65, 0x20, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x2e, 0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x65, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0x54,
+	0x68, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65,
+	0x72, 0x2e, 0x52, 0x06, 0x62, 0x65, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x19, 0x54,
+	0x68, 0x65, 0x20, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x77, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x61, 0x92, 0x41, 0x5e, 0x32, 0x5c, 0x54, 0x68, 0x65, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20,
+	0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x77, 0x61, 0x73, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x2e, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x63, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0x92,
+	0x41, 0x41, 0x32, 0x3f, 0x54, 0x68, 0x65, 0x20, 0x58, 0x2d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2d, 0x49, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c, 0x20, 0x69, 0x66, 0x20, 0x61,
+	0x6e, 0x79, 0x2e, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x57,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x2f, 0x92,
+	0x41, 0x2c, 0x32, 0x2a, 0x54, 0x68, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68,
+	0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x2e, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x60, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0x54,
+	0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x20, 0x77, 0x61, 0x73, 0x20, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x6b, 0x92, 0x41, 0x68, 0x0a, 0x66,
+	0x2a, 0x0e, 0x42, 0x65, 0x65, 0x72, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x32, 0x2e, 0x41, 0x6e, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e,
+	0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x62, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xd2,
+	0x01, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x65, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92,
+	0x41, 0x16, 0x32, 0x0f, 0x42, 0x65, 0x65, 0x72, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x4e, 0x92, 0x41,
+	0x4b, 0x0a, 0x49, 0x2a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2a, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20,
+	0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x22, 0xcf, 0x01, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x42, 0x65, 0x65, 0x72,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x32,
+	0x2c, 0x54, 0x68, 0x65, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c, 0x20,
+	0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x56, 0x92, 0x41, 0x53, 0x0a, 0x51, 0x2a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x2c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe8,
+	0x05, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0x92, 0x41, 0x27, 0x32, 0x25, 0x54, 0x68, 0x65, 0x20,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79,
+	0x2e, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65,
+	0x79, 0x2e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x70, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x58, 0x92, 0x41, 0x55, 0x32, 0x53, 0x54,
+	0x68, 0x65, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x73, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20,
+	0x65, 0x2e, 0x67, 0x2e, 0x20, 0x22, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x2e, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x63, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x26, 0x92, 0x41, 0x23,
+	0x32, 0x21, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41,
+	0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x2e, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x5f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x2e, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x79, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x37, 0x54, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2c, 0x20, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x20,
+	0x69, 0x66, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x2e, 0x52,
+	0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0xbb, 0x01, 0x92, 0x41,
+	0xb7, 0x01, 0x0a, 0xb4, 0x01, 0x2a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x32, 0x79, 0x41,
+	0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73,
+	0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x58, 0x2d, 0x41, 0x70, 0x69, 0x2d, 0x4b, 0x65,
+	0x79, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x6b, 0x65,
+	0x79, 0x20, 0x69, 0x74, 0x73, 0x65, 0x6c, 0x66, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x65, 0x76, 0x65,
+	0x72, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x73, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0xd2, 0x01, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0xd2, 0x01, 0x0b, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0x54, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x70, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x58, 0x92, 0x41, 0x55, 0x32, 0x53, 0x54, 0x68, 0x65, 0x20, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x20, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e,
+	0x20, 0x22, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x2e, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0x54, 0x68,
+	0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b,
+	0x65, 0x79, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x2e, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x5a, 0x92, 0x41, 0x57, 0x0a, 0x55, 0x2a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x49,
+	0x20, 0x6b, 0x65, 0x79, 0x2e, 0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x06, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0xd2, 0x01, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x54, 0x68, 0x65,
+	0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x12, 0x5d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b,
+	0x92, 0x41, 0x48, 0x32, 0x46, 0x54, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20,
+	0x73, 0x65, 0x6e, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x58, 0x2d, 0x41, 0x70,
+	0x69, 0x2d, 0x4b, 0x65, 0x79, 0x20, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x20, 0x49, 0x74,
+	0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x22, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79,
+	0x2e, 0xd2, 0x01, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0xd2, 0x01, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x4e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x38, 0x92, 0x41, 0x35, 0x0a, 0x33, 0x2a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x73,
+	0x2e, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x54, 0x68, 0x65, 0x20, 0x41,
+	0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x3a, 0x46, 0x92, 0x41, 0x43, 0x0a, 0x41, 0x2a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x1f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0xd2, 0x01,
+	0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92,
+	0x41, 0x19, 0x32, 0x12, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0xd2, 0x01, 0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x3a,
+	0x41, 0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x2a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x20, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2e, 0xd2, 0x01, 0x02,
+	0x69, 0x64, 0x22, 0x62, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xdb, 0x01, 0x0a, 0x08, 0x42, 0x65, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x45, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
+	0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x45, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x41, 0x47, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x49, 0x41, 0x5f,
+	0x50, 0x41, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x45,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12,
+	0x15, 0x0a, 0x11, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x49, 0x4c,
+	0x53, 0x4e, 0x45, 0x52, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x52, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12,
+	0x42, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x4c, 0x45, 0x5f, 0x41,
+	0x4c, 0x45, 0x10, 0x08, 0x2a, 0x87, 0x01, 0x0a, 0x0d, 0x42, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x45, 0x45, 0x52, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x45, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x91,
+	0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x04, 0x32, 0x9f, 0x26, 0x0a, 0x0b, 0x42, 0x65, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0xe3, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65,
+	0x72, 0x12, 0x12, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0xb9, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x9d, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65,
+	0x72, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x2e, 0x2a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a,
+	0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xaf, 0x02, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x42, 0x65, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0x8b, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0xed, 0x01, 0x0a, 0x04, 0x62,
+	0x65, 0x65, 0x72, 0x12, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69,
+	0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x2a, 0x07, 0x67, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03,
+	0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a,
+	0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x9d, 0x03, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e,
+	0x42, 0x65, 0x65, 0x72, 0x22, 0xf3, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x32, 0x17, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x65,
+	0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0x3a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x92, 0x41, 0xca, 0x02,
+	0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x2a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61,
+	0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c,
+	0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12,
+	0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12,
+	0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x55, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12,
+	0x4e, 0x0a, 0x40, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x77, 0x61, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a,
+	0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xa3, 0x03, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xe8, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x92, 0x41, 0xca, 0x02, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x22, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x2a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34,
+	0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e,
+	0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x55,
+	0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x4e, 0x0a, 0x40, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20,
+	0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x77, 0x61, 0x73,
+	0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0xd5, 0x03, 0x0a, 0x0c, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0xa7,
+	0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x75, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0xfd, 0x02, 0x0a, 0x04, 0x62, 0x65, 0x65,
+	0x72, 0x12, 0x2c, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69,
+	0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x2a,
+	0x0c, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x16, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x0f, 0x0a, 0x02, 0x4f, 0x4b, 0x12, 0x09, 0x0a, 0x07, 0x1a, 0x05,
+	0x2e, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x3c, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x35, 0x0a, 0x27,
+	0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a,
+	0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a,
+	0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x55, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x4e, 0x0a,
+	0x40, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x65, 0x74, 0x61, 0x67, 0x20, 0x77, 0x61, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xed, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x65, 0x65, 0x72, 0x73, 0x92, 0x41, 0x9f, 0x01, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73,
+	0x12, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x2e, 0x2a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30,
+	0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a,
+	0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a,
+	0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08,
+	0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xb7, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xfc, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x92, 0x41, 0xdc, 0x01, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x12, 0x29, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x62, 0x79, 0x20, 0x6e,
+	0x61, 0x6d, 0x65, 0x2c, 0x20, 0x62, 0x72, 0x65, 0x77, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x2a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55,
 	0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a,
 	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17,
 	0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a,
 	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
 	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
 	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0xaf, 0x02, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x12, 0x0f,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0x8b, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
-	0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0xed, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x1f, 0x47,
-	0x65, 0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76,
-	0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x2a, 0x07,
-	0x67, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
-	0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42,
-	0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a,
-	0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a,
-	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33,
-	0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a,
-	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34,
-	0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a,
+	0x6f, 0x72, 0x12, 0xe4, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa3, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x92, 0x41, 0x81, 0x02, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x2c, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20,
+	0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x2a, 0x0d, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34,
+	0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e,
+	0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a,
+	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xf8, 0x02, 0x0a, 0x10, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x92, 0x41, 0x86, 0x02, 0x0a, 0x05,
+	0x62, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x2a,
+	0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
+	0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4a, 0x34, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x2d, 0x0a, 0x1f, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x2c, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x61,
+	0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x0a, 0x0a,
 	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66,
 	0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
 	0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0xc6, 0x02, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0x9c,
-	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x32, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x65, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d,
-	0x3a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x92, 0x41, 0xf3, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72,
-	0x12, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69,
-	0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x2e, 0x2a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0xcb, 0x03, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x84, 0x03, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65,
+	0x65, 0x72, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a,
+	0x01, 0x2a, 0x92, 0x41, 0xdc, 0x02, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x20, 0x6f, 0x66,
+	0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76, 0x65,
+	0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x2a, 0x10,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x73,
 	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a,
 	0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
@@ -718,63 +3330,170 @@ var file_api_proto_rawDesc = []byte{
 	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
 	0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74,
 	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a,
-	0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xcc, 0x02,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x91, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x14, 0x2a, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0xf3, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12,
-	0x22, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74,
-	0x68, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2e, 0x2a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x65, 0x72, 0x4a,
-	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03,
-	0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21,
+	0x6f, 0x72, 0x4a, 0x53, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x4c, 0x0a, 0x3e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x2c, 0x20, 0x61, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x68, 0x61,
+	0x73, 0x20, 0x62, 0x65, 0x65, 0x6e, 0x20, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x69, 0x74, 0x73, 0x20, 0x65, 0x74, 0x61, 0x67, 0x20, 0x77,
+	0x61, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0xb5, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xee, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x92, 0x41,
+	0xc8, 0x01, 0x0a, 0x04, 0x62, 0x65, 0x65, 0x72, 0x12, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x67, 0x69, 0x76,
+	0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x2a, 0x0f,
+	0x6c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03,
+	0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a,
+	0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xe1, 0x02, 0x0a, 0x0a, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e,
+	0x42, 0x65, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb0, 0x02, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72,
+	0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x92, 0x41, 0x91, 0x02, 0x0a, 0x05, 0x62, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x3e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x6e, 0x65, 0x77, 0x6c, 0x69,
+	0x6e, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x53, 0x4f,
+	0x4e, 0x2e, 0x2a, 0x0a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x41, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x3a, 0x0a, 0x2c, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x68, 0x61, 0x73, 0x20, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21,
 	0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62,
 	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x20,
-	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10,
 	0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xed, 0x01, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xb8, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x92, 0x41, 0x9f, 0x01, 0x0a, 0x05, 0x62,
-	0x65, 0x65, 0x72, 0x73, 0x12, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20,
-	0x62, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x2a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x65, 0x65, 0x72,
-	0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21,
+	0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x30, 0x01, 0x12, 0x95,
+	0x02, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x05, 0x2e, 0x42, 0x65, 0x65, 0x72, 0x22, 0xe7, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65,
+	0x72, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x92, 0x41, 0xc7, 0x01, 0x0a, 0x05, 0x62,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x36, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x65, 0x76, 0x65,
+	0x72, 0x79, 0x20, 0x62, 0x65, 0x65, 0x72, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65,
+	0x64, 0x20, 0x61, 0x73, 0x20, 0x6e, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x64, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x53, 0x4f, 0x4e, 0x2e, 0x2a, 0x0b, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a,
+	0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a,
+	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33,
+	0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a,
+	0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x30, 0x01, 0x12, 0xcb, 0x02, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x8e, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x65, 0x72, 0x73, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x3a, 0x01, 0x2a, 0x92, 0x41, 0xeb, 0x01, 0x0a, 0x05, 0x62, 0x65, 0x65, 0x72, 0x73, 0x12, 0x38,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x62, 0x65, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x6e, 0x65, 0x77, 0x6c, 0x69, 0x6e,
+	0x65, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x53, 0x4f, 0x4e,
+	0x20, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x2e, 0x2a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x65, 0x65, 0x72, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55,
+	0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17,
+	0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a,
+	0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x28, 0x01, 0x32, 0x96, 0x07, 0x0a, 0x0d, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa3, 0x02, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe5, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x73, 0x3a, 0x01,
+	0x2a, 0x92, 0x41, 0xc7, 0x01, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79,
+	0x2e, 0x2a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x21,
 	0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62,
 	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10,
 	0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x8e, 0x02, 0x5a,
-	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x76, 0x77, 0x65,
-	0x6c, 0x6c, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
-	0x65, 0x65, 0x72, 0x73, 0x92, 0x41, 0xd5, 0x01, 0x12, 0xaa, 0x01, 0x0a, 0x08, 0x42, 0x65, 0x65,
-	0x72, 0x20, 0x41, 0x50, 0x49, 0x12, 0x46, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72, 0x20,
-	0x41, 0x50, 0x49, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20,
-	0x61, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x41, 0x50, 0x49, 0x73, 0x20, 0x66, 0x6f,
-	0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62, 0x65,
-	0x65, 0x72, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x2e, 0x22, 0x51, 0x0a,
-	0x09, 0x42, 0x65, 0x6e, 0x20, 0x57, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x2f, 0x68, 0x74, 0x74, 0x70,
-	0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x76, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x13, 0x62, 0x2e, 0x76,
-	0x2e, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d,
-	0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xfb, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x13, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79,
+	0x73, 0x92, 0x41, 0xa5, 0x01, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x12, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x73, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79,
+	0x73, 0x2e, 0x2a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x21, 0x0a, 0x03,
+	0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x10, 0x55, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0a,
+	0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0xe0, 0x02, 0x0a, 0x0c, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa1, 0x02, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x6b,
+	0x65, 0x79, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x92,
+	0x41, 0xfa, 0x01, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x2a, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x20, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x0b, 0x42, 0x61, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+	0x21, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x1a, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x17, 0x0a, 0x09, 0x46, 0x6f, 0x72,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4a, 0x1e, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x17, 0x0a, 0x09, 0x4e, 0x6f, 0x74,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x4a, 0x29, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a,
+	0x10, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x0a, 0x0a, 0x08, 0x1a, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x8e, 0x02,
+	0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x76, 0x77,
+	0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x62, 0x65, 0x65, 0x72, 0x73, 0x92, 0x41, 0xd5, 0x01, 0x12, 0xaa, 0x01, 0x0a, 0x08, 0x42, 0x65,
+	0x65, 0x72, 0x20, 0x41, 0x50, 0x49, 0x12, 0x46, 0x54, 0x68, 0x65, 0x20, 0x62, 0x65, 0x65, 0x72,
+	0x20, 0x41, 0x50, 0x49, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x73, 0x65, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x41, 0x50, 0x49, 0x73, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x62,
+	0x65, 0x65, 0x72, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x2e, 0x22, 0x51,
+	0x0a, 0x09, 0x42, 0x65, 0x6e, 0x20, 0x57, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x2f, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x76, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2d, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x13, 0x62, 0x2e,
+	0x76, 0x2e, 0x77, 0x65, 0x6c, 0x6c, 0x73, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -789,42 +3508,119 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_proto_goTypes = []interface{}{
-	(BeerType)(0),                // 0: BeerType
-	(*Beer)(nil),                 // 1: Beer
-	(*CreateBeerRequest)(nil),    // 2: CreateBeerRequest
-	(*GetBeerRequest)(nil),       // 3: GetBeerRequest
-	(*UpdateBeerRequest)(nil),    // 4: UpdateBeerRequest
-	(*DeleteBeerRequest)(nil),    // 5: DeleteBeerRequest
-	(*ListBeersRequest)(nil),     // 6: ListBeersRequest
-	(*ListBeersResponse)(nil),    // 7: ListBeersResponse
-	(*Error)(nil),                // 8: Error
-	(*field_mask.FieldMask)(nil), // 9: google.protobuf.FieldMask
-	(*empty.Empty)(nil),          // 10: google.protobuf.Empty
+	(BeerType)(0),                    // 0: BeerType
+	(BeerEventType)(0),               // 1: BeerEventType
+	(AuditAction)(0),                 // 2: AuditAction
+	(*Beer)(nil),                     // 3: Beer
+	(*CreateBeerRequest)(nil),        // 4: CreateBeerRequest
+	(*GetBeerRequest)(nil),           // 5: GetBeerRequest
+	(*UpdateBeerRequest)(nil),        // 6: UpdateBeerRequest
+	(*DeleteBeerRequest)(nil),        // 7: DeleteBeerRequest
+	(*UndeleteBeerRequest)(nil),      // 8: UndeleteBeerRequest
+	(*ListBeersRequest)(nil),         // 9: ListBeersRequest
+	(*ListBeersResponse)(nil),        // 10: ListBeersResponse
+	(*SearchBeersRequest)(nil),       // 11: SearchBeersRequest
+	(*Snippet)(nil),                  // 12: Snippet
+	(*SearchResult)(nil),             // 13: SearchResult
+	(*SearchBeersResponse)(nil),      // 14: SearchBeersResponse
+	(*BatchGetBeersRequest)(nil),     // 15: BatchGetBeersRequest
+	(*BatchGetBeersResponse)(nil),    // 16: BatchGetBeersResponse
+	(*BatchCreateBeersRequest)(nil),  // 17: BatchCreateBeersRequest
+	(*BatchCreateBeersResponse)(nil), // 18: BatchCreateBeersResponse
+	(*BatchDeleteBeersRequest)(nil),  // 19: BatchDeleteBeersRequest
+	(*WatchBeersRequest)(nil),        // 20: WatchBeersRequest
+	(*BeerEvent)(nil),                // 21: BeerEvent
+	(*ExportBeersRequest)(nil),       // 22: ExportBeersRequest
+	(*ImportBeersRequest)(nil),       // 23: ImportBeersRequest
+	(*ImportError)(nil),              // 24: ImportError
+	(*ImportBeersResponse)(nil),      // 25: ImportBeersResponse
+	(*FieldChange)(nil),              // 26: FieldChange
+	(*BeerAuditEntry)(nil),           // 27: BeerAuditEntry
+	(*ListBeerHistoryRequest)(nil),   // 28: ListBeerHistoryRequest
+	(*ListBeerHistoryResponse)(nil),  // 29: ListBeerHistoryResponse
+	(*APIKey)(nil),                   // 30: APIKey
+	(*CreateAPIKeyRequest)(nil),      // 31: CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),     // 32: CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),       // 33: ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),      // 34: ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),      // 35: RevokeAPIKeyRequest
+	(*Error)(nil),                    // 36: Error
+	(*timestamp.Timestamp)(nil),      // 37: google.protobuf.Timestamp
+	(*field_mask.FieldMask)(nil),     // 38: google.protobuf.FieldMask
+	(*empty.Empty)(nil),              // 39: google.protobuf.Empty
 }
 var file_api_proto_depIdxs = []int32{
 	0,  // 0: Beer.type:type_name -> BeerType
-	0,  // 1: CreateBeerRequest.type:type_name -> BeerType
-	1,  // 2: UpdateBeerRequest.beer:type_name -> Beer
-	9,  // 3: UpdateBeerRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 4: ListBeersResponse.beers:type_name -> Beer
-	2,  // 5: BeerService.CreateBeer:input_type -> CreateBeerRequest
-	3,  // 6: BeerService.GetBeer:input_type -> GetBeerRequest
-	4,  // 7: BeerService.UpdateBeer:input_type -> UpdateBeerRequest
-	5,  // 8: BeerService.DeleteBeer:input_type -> DeleteBeerRequest
-	6,  // 9: BeerService.ListBeers:input_type -> ListBeersRequest
-	1,  // 10: BeerService.CreateBeer:output_type -> Beer
-	1,  // 11: BeerService.GetBeer:output_type -> Beer
-	1,  // 12: BeerService.UpdateBeer:output_type -> Beer
-	10, // 13: BeerService.DeleteBeer:output_type -> google.protobuf.Empty
-	7,  // 14: BeerService.ListBeers:output_type -> ListBeersResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	37, // 1: Beer.delete_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: CreateBeerRequest.type:type_name -> BeerType
+	3,  // 3: UpdateBeerRequest.beer:type_name -> Beer
+	38, // 4: UpdateBeerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 5: ListBeersRequest.type:type_name -> BeerType
+	3,  // 6: ListBeersResponse.beers:type_name -> Beer
+	3,  // 7: SearchResult.beer:type_name -> Beer
+	12, // 8: SearchResult.snippets:type_name -> Snippet
+	13, // 9: SearchBeersResponse.results:type_name -> SearchResult
+	3,  // 10: BatchGetBeersResponse.beers:type_name -> Beer
+	4,  // 11: BatchCreateBeersRequest.requests:type_name -> CreateBeerRequest
+	3,  // 12: BatchCreateBeersResponse.beers:type_name -> Beer
+	7,  // 13: BatchDeleteBeersRequest.requests:type_name -> DeleteBeerRequest
+	1,  // 14: BeerEvent.type:type_name -> BeerEventType
+	3,  // 15: BeerEvent.beer:type_name -> Beer
+	37, // 16: BeerEvent.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 17: ImportBeersRequest.beers:type_name -> Beer
+	24, // 18: ImportBeersResponse.errors:type_name -> ImportError
+	2,  // 19: BeerAuditEntry.action:type_name -> AuditAction
+	26, // 20: BeerAuditEntry.changes:type_name -> FieldChange
+	37, // 21: BeerAuditEntry.create_time:type_name -> google.protobuf.Timestamp
+	27, // 22: ListBeerHistoryResponse.entries:type_name -> BeerAuditEntry
+	37, // 23: APIKey.create_time:type_name -> google.protobuf.Timestamp
+	37, // 24: APIKey.expire_time:type_name -> google.protobuf.Timestamp
+	37, // 25: APIKey.revoke_time:type_name -> google.protobuf.Timestamp
+	37, // 26: CreateAPIKeyRequest.expire_time:type_name -> google.protobuf.Timestamp
+	30, // 27: CreateAPIKeyResponse.api_key:type_name -> APIKey
+	30, // 28: ListAPIKeysResponse.api_keys:type_name -> APIKey
+	4,  // 29: BeerService.CreateBeer:input_type -> CreateBeerRequest
+	5,  // 30: BeerService.GetBeer:input_type -> GetBeerRequest
+	6,  // 31: BeerService.UpdateBeer:input_type -> UpdateBeerRequest
+	7,  // 32: BeerService.DeleteBeer:input_type -> DeleteBeerRequest
+	8,  // 33: BeerService.UndeleteBeer:input_type -> UndeleteBeerRequest
+	9,  // 34: BeerService.ListBeers:input_type -> ListBeersRequest
+	11, // 35: BeerService.SearchBeers:input_type -> SearchBeersRequest
+	15, // 36: BeerService.BatchGetBeers:input_type -> BatchGetBeersRequest
+	17, // 37: BeerService.BatchCreateBeers:input_type -> BatchCreateBeersRequest
+	19, // 38: BeerService.BatchDeleteBeers:input_type -> BatchDeleteBeersRequest
+	28, // 39: BeerService.ListBeerHistory:input_type -> ListBeerHistoryRequest
+	20, // 40: BeerService.WatchBeers:input_type -> WatchBeersRequest
+	22, // 41: BeerService.ExportBeers:input_type -> ExportBeersRequest
+	23, // 42: BeerService.ImportBeers:input_type -> ImportBeersRequest
+	31, // 43: APIKeyService.CreateAPIKey:input_type -> CreateAPIKeyRequest
+	33, // 44: APIKeyService.ListAPIKeys:input_type -> ListAPIKeysRequest
+	35, // 45: APIKeyService.RevokeAPIKey:input_type -> RevokeAPIKeyRequest
+	3,  // 46: BeerService.CreateBeer:output_type -> Beer
+	3,  // 47: BeerService.GetBeer:output_type -> Beer
+	3,  // 48: BeerService.UpdateBeer:output_type -> Beer
+	39, // 49: BeerService.DeleteBeer:output_type -> google.protobuf.Empty
+	3,  // 50: BeerService.UndeleteBeer:output_type -> Beer
+	10, // 51: BeerService.ListBeers:output_type -> ListBeersResponse
+	14, // 52: BeerService.SearchBeers:output_type -> SearchBeersResponse
+	16, // 53: BeerService.BatchGetBeers:output_type -> BatchGetBeersResponse
+	18, // 54: BeerService.BatchCreateBeers:output_type -> BatchCreateBeersResponse
+	39, // 55: BeerService.BatchDeleteBeers:output_type -> google.protobuf.Empty
+	29, // 56: BeerService.ListBeerHistory:output_type -> ListBeerHistoryResponse
+	21, // 57: BeerService.WatchBeers:output_type -> BeerEvent
+	3,  // 58: BeerService.ExportBeers:output_type -> Beer
+	25, // 59: BeerService.ImportBeers:output_type -> ImportBeersResponse
+	32, // 60: APIKeyService.CreateAPIKey:output_type -> CreateAPIKeyResponse
+	34, // 61: APIKeyService.ListAPIKeys:output_type -> ListAPIKeysResponse
+	39, // 62: APIKeyService.RevokeAPIKey:output_type -> google.protobuf.Empty
+	46, // [46:63] is the sub-list for method output_type
+	29, // [29:46] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -845,8 +3641,152 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBeerRequest); i {
+		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteBeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snippet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBeersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetBeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetBeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +3797,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBeerRequest); i {
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateBeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +3809,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBeerRequest); i {
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateBeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,8 +3821,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBeerRequest); i {
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteBeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,8 +3833,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeersRequest); i {
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchBeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,8 +3845,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBeersResponse); i {
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeerEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,7 +3857,175 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBeersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeerAuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeerHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBeerHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
@@ -935,10 +4043,10 @@ func file_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_proto_goTypes,
 		DependencyIndexes: file_api_proto_depIdxs,
@@ -969,10 +4077,36 @@ type BeerServiceClient interface {
 	GetBeer(ctx context.Context, in *GetBeerRequest, opts ...grpc.CallOption) (*Beer, error)
 	// UpdateBeer updates a beer given its ID.
 	UpdateBeer(ctx context.Context, in *UpdateBeerRequest, opts ...grpc.CallOption) (*Beer, error)
-	// DeleteBeer deletes a beer given its ID.
+	// DeleteBeer deletes a beer given its ID. Deleted beers can be undeleted
+	// until they are purged.
 	DeleteBeer(ctx context.Context, in *DeleteBeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UndeleteBeer undeletes a deleted beer given its ID.
+	UndeleteBeer(ctx context.Context, in *UndeleteBeerRequest, opts ...grpc.CallOption) (*Beer, error)
 	// ListBeers lists beers.
 	ListBeers(ctx context.Context, in *ListBeersRequest, opts ...grpc.CallOption) (*ListBeersResponse, error)
+	// SearchBeers searches beers by name, brewer and country, ranking the beers
+	// by how well they match.
+	SearchBeers(ctx context.Context, in *SearchBeersRequest, opts ...grpc.CallOption) (*SearchBeersResponse, error)
+	// BatchGetBeers gets a batch of beers given their identifiers. Either every
+	// beer is returned or the call fails.
+	BatchGetBeers(ctx context.Context, in *BatchGetBeersRequest, opts ...grpc.CallOption) (*BatchGetBeersResponse, error)
+	// BatchCreateBeers creates a batch of beers. Either every beer is created or
+	// none are.
+	BatchCreateBeers(ctx context.Context, in *BatchCreateBeersRequest, opts ...grpc.CallOption) (*BatchCreateBeersResponse, error)
+	// BatchDeleteBeers deletes a batch of beers given their identifiers. Either
+	// every beer is deleted or none are.
+	BatchDeleteBeers(ctx context.Context, in *BatchDeleteBeersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListBeerHistory lists the audit entries of a beer given its ID.
+	ListBeerHistory(ctx context.Context, in *ListBeerHistoryRequest, opts ...grpc.CallOption) (*ListBeerHistoryResponse, error)
+	// WatchBeers streams the events of beers as they change.
+	WatchBeers(ctx context.Context, in *WatchBeersRequest, opts ...grpc.CallOption) (BeerService_WatchBeersClient, error)
+	// ExportBeers streams every beer, as of a consistent snapshot, in
+	// identifier order.
+	ExportBeers(ctx context.Context, in *ExportBeersRequest, opts ...grpc.CallOption) (BeerService_ExportBeersClient, error)
+	// ImportBeers imports the beers streamed in chunks, committing them in
+	// batches, and returns a summary of the import. Beers which already exist
+	// are skipped, so a failed import can be retried.
+	ImportBeers(ctx context.Context, opts ...grpc.CallOption) (BeerService_ImportBeersClient, error)
 }
 
 type beerServiceClient struct {
@@ -989,43 +4123,195 @@ func (c *beerServiceClient) CreateBeer(ctx context.Context, in *CreateBeerReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *beerServiceClient) GetBeer(ctx context.Context, in *GetBeerRequest, opts ...grpc.CallOption) (*Beer, error) {
+	out := new(Beer)
+	err := c.cc.Invoke(ctx, "/BeerService/GetBeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) UpdateBeer(ctx context.Context, in *UpdateBeerRequest, opts ...grpc.CallOption) (*Beer, error) {
+	out := new(Beer)
+	err := c.cc.Invoke(ctx, "/BeerService/UpdateBeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) DeleteBeer(ctx context.Context, in *DeleteBeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/BeerService/DeleteBeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) UndeleteBeer(ctx context.Context, in *UndeleteBeerRequest, opts ...grpc.CallOption) (*Beer, error) {
+	out := new(Beer)
+	err := c.cc.Invoke(ctx, "/BeerService/UndeleteBeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) ListBeers(ctx context.Context, in *ListBeersRequest, opts ...grpc.CallOption) (*ListBeersResponse, error) {
+	out := new(ListBeersResponse)
+	err := c.cc.Invoke(ctx, "/BeerService/ListBeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) SearchBeers(ctx context.Context, in *SearchBeersRequest, opts ...grpc.CallOption) (*SearchBeersResponse, error) {
+	out := new(SearchBeersResponse)
+	err := c.cc.Invoke(ctx, "/BeerService/SearchBeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) BatchGetBeers(ctx context.Context, in *BatchGetBeersRequest, opts ...grpc.CallOption) (*BatchGetBeersResponse, error) {
+	out := new(BatchGetBeersResponse)
+	err := c.cc.Invoke(ctx, "/BeerService/BatchGetBeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) BatchCreateBeers(ctx context.Context, in *BatchCreateBeersRequest, opts ...grpc.CallOption) (*BatchCreateBeersResponse, error) {
+	out := new(BatchCreateBeersResponse)
+	err := c.cc.Invoke(ctx, "/BeerService/BatchCreateBeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) BatchDeleteBeers(ctx context.Context, in *BatchDeleteBeersRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/BeerService/BatchDeleteBeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) ListBeerHistory(ctx context.Context, in *ListBeerHistoryRequest, opts ...grpc.CallOption) (*ListBeerHistoryResponse, error) {
+	out := new(ListBeerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/BeerService/ListBeerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beerServiceClient) WatchBeers(ctx context.Context, in *WatchBeersRequest, opts ...grpc.CallOption) (BeerService_WatchBeersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeerService_serviceDesc.Streams[0], "/BeerService/WatchBeers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beerServiceWatchBeersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeerService_WatchBeersClient interface {
+	Recv() (*BeerEvent, error)
+	grpc.ClientStream
+}
+
+type beerServiceWatchBeersClient struct {
+	grpc.ClientStream
+}
+
+func (x *beerServiceWatchBeersClient) Recv() (*BeerEvent, error) {
+	m := new(BeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beerServiceClient) ExportBeers(ctx context.Context, in *ExportBeersRequest, opts ...grpc.CallOption) (BeerService_ExportBeersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeerService_serviceDesc.Streams[1], "/BeerService/ExportBeers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beerServiceExportBeersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *beerServiceClient) GetBeer(ctx context.Context, in *GetBeerRequest, opts ...grpc.CallOption) (*Beer, error) {
-	out := new(Beer)
-	err := c.cc.Invoke(ctx, "/BeerService/GetBeer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BeerService_ExportBeersClient interface {
+	Recv() (*Beer, error)
+	grpc.ClientStream
 }
 
-func (c *beerServiceClient) UpdateBeer(ctx context.Context, in *UpdateBeerRequest, opts ...grpc.CallOption) (*Beer, error) {
-	out := new(Beer)
-	err := c.cc.Invoke(ctx, "/BeerService/UpdateBeer", in, out, opts...)
-	if err != nil {
+type beerServiceExportBeersClient struct {
+	grpc.ClientStream
+}
+
+func (x *beerServiceExportBeersClient) Recv() (*Beer, error) {
+	m := new(Beer)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *beerServiceClient) DeleteBeer(ctx context.Context, in *DeleteBeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/BeerService/DeleteBeer", in, out, opts...)
+func (c *beerServiceClient) ImportBeers(ctx context.Context, opts ...grpc.CallOption) (BeerService_ImportBeersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeerService_serviceDesc.Streams[2], "/BeerService/ImportBeers", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &beerServiceImportBeersClient{stream}
+	return x, nil
 }
 
-func (c *beerServiceClient) ListBeers(ctx context.Context, in *ListBeersRequest, opts ...grpc.CallOption) (*ListBeersResponse, error) {
-	out := new(ListBeersResponse)
-	err := c.cc.Invoke(ctx, "/BeerService/ListBeers", in, out, opts...)
-	if err != nil {
+type BeerService_ImportBeersClient interface {
+	Send(*ImportBeersRequest) error
+	CloseAndRecv() (*ImportBeersResponse, error)
+	grpc.ClientStream
+}
+
+type beerServiceImportBeersClient struct {
+	grpc.ClientStream
+}
+
+func (x *beerServiceImportBeersClient) Send(m *ImportBeersRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *beerServiceImportBeersClient) CloseAndRecv() (*ImportBeersResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	m := new(ImportBeersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 // BeerServiceServer is the server API for BeerService service.
@@ -1036,10 +4322,36 @@ type BeerServiceServer interface {
 	GetBeer(context.Context, *GetBeerRequest) (*Beer, error)
 	// UpdateBeer updates a beer given its ID.
 	UpdateBeer(context.Context, *UpdateBeerRequest) (*Beer, error)
-	// DeleteBeer deletes a beer given its ID.
+	// DeleteBeer deletes a beer given its ID. Deleted beers can be undeleted
+	// until they are purged.
 	DeleteBeer(context.Context, *DeleteBeerRequest) (*empty.Empty, error)
+	// UndeleteBeer undeletes a deleted beer given its ID.
+	UndeleteBeer(context.Context, *UndeleteBeerRequest) (*Beer, error)
 	// ListBeers lists beers.
 	ListBeers(context.Context, *ListBeersRequest) (*ListBeersResponse, error)
+	// SearchBeers searches beers by name, brewer and country, ranking the beers
+	// by how well they match.
+	SearchBeers(context.Context, *SearchBeersRequest) (*SearchBeersResponse, error)
+	// BatchGetBeers gets a batch of beers given their identifiers. Either every
+	// beer is returned or the call fails.
+	BatchGetBeers(context.Context, *BatchGetBeersRequest) (*BatchGetBeersResponse, error)
+	// BatchCreateBeers creates a batch of beers. Either every beer is created or
+	// none are.
+	BatchCreateBeers(context.Context, *BatchCreateBeersRequest) (*BatchCreateBeersResponse, error)
+	// BatchDeleteBeers deletes a batch of beers given their identifiers. Either
+	// every beer is deleted or none are.
+	BatchDeleteBeers(context.Context, *BatchDeleteBeersRequest) (*empty.Empty, error)
+	// ListBeerHistory lists the audit entries of a beer given its ID.
+	ListBeerHistory(context.Context, *ListBeerHistoryRequest) (*ListBeerHistoryResponse, error)
+	// WatchBeers streams the events of beers as they change.
+	WatchBeers(*WatchBeersRequest, BeerService_WatchBeersServer) error
+	// ExportBeers streams every beer, as of a consistent snapshot, in
+	// identifier order.
+	ExportBeers(*ExportBeersRequest, BeerService_ExportBeersServer) error
+	// ImportBeers imports the beers streamed in chunks, committing them in
+	// batches, and returns a summary of the import. Beers which already exist
+	// are skipped, so a failed import can be retried.
+	ImportBeers(BeerService_ImportBeersServer) error
 }
 
 // UnimplementedBeerServiceServer can be embedded to have forward compatible implementations.
@@ -1058,9 +4370,36 @@ func (*UnimplementedBeerServiceServer) UpdateBeer(context.Context, *UpdateBeerRe
 func (*UnimplementedBeerServiceServer) DeleteBeer(context.Context, *DeleteBeerRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBeer not implemented")
 }
+func (*UnimplementedBeerServiceServer) UndeleteBeer(context.Context, *UndeleteBeerRequest) (*Beer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteBeer not implemented")
+}
 func (*UnimplementedBeerServiceServer) ListBeers(context.Context, *ListBeersRequest) (*ListBeersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBeers not implemented")
 }
+func (*UnimplementedBeerServiceServer) SearchBeers(context.Context, *SearchBeersRequest) (*SearchBeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) BatchGetBeers(context.Context, *BatchGetBeersRequest) (*BatchGetBeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) BatchCreateBeers(context.Context, *BatchCreateBeersRequest) (*BatchCreateBeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) BatchDeleteBeers(context.Context, *BatchDeleteBeersRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) ListBeerHistory(context.Context, *ListBeerHistoryRequest) (*ListBeerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBeerHistory not implemented")
+}
+func (*UnimplementedBeerServiceServer) WatchBeers(*WatchBeersRequest, BeerService_WatchBeersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) ExportBeers(*ExportBeersRequest, BeerService_ExportBeersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBeers not implemented")
+}
+func (*UnimplementedBeerServiceServer) ImportBeers(BeerService_ImportBeersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBeers not implemented")
+}
 
 func RegisterBeerServiceServer(s *grpc.Server, srv BeerServiceServer) {
 	s.RegisterService(&_BeerService_serviceDesc, srv)
@@ -1138,6 +4477,24 @@ func _BeerService_DeleteBeer_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeerService_UndeleteBeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteBeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).UndeleteBeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/UndeleteBeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).UndeleteBeer(ctx, req.(*UndeleteBeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BeerService_ListBeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBeersRequest)
 	if err := dec(in); err != nil {
@@ -1156,6 +4513,164 @@ func _BeerService_ListBeers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeerService_SearchBeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).SearchBeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/SearchBeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).SearchBeers(ctx, req.(*SearchBeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeerService_BatchGetBeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetBeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).BatchGetBeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/BatchGetBeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).BatchGetBeers(ctx, req.(*BatchGetBeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeerService_BatchCreateBeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateBeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).BatchCreateBeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/BatchCreateBeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).BatchCreateBeers(ctx, req.(*BatchCreateBeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeerService_BatchDeleteBeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).BatchDeleteBeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/BatchDeleteBeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).BatchDeleteBeers(ctx, req.(*BatchDeleteBeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeerService_ListBeerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBeerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeerServiceServer).ListBeerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BeerService/ListBeerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeerServiceServer).ListBeerHistory(ctx, req.(*ListBeerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeerService_WatchBeers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBeersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeerServiceServer).WatchBeers(m, &beerServiceWatchBeersServer{stream})
+}
+
+type BeerService_WatchBeersServer interface {
+	Send(*BeerEvent) error
+	grpc.ServerStream
+}
+
+type beerServiceWatchBeersServer struct {
+	grpc.ServerStream
+}
+
+func (x *beerServiceWatchBeersServer) Send(m *BeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeerService_ExportBeers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBeersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeerServiceServer).ExportBeers(m, &beerServiceExportBeersServer{stream})
+}
+
+type BeerService_ExportBeersServer interface {
+	Send(*Beer) error
+	grpc.ServerStream
+}
+
+type beerServiceExportBeersServer struct {
+	grpc.ServerStream
+}
+
+func (x *beerServiceExportBeersServer) Send(m *Beer) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeerService_ImportBeers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BeerServiceServer).ImportBeers(&beerServiceImportBeersServer{stream})
+}
+
+type BeerService_ImportBeersServer interface {
+	SendAndClose(*ImportBeersResponse) error
+	Recv() (*ImportBeersRequest, error)
+	grpc.ServerStream
+}
+
+type beerServiceImportBeersServer struct {
+	grpc.ServerStream
+}
+
+func (x *beerServiceImportBeersServer) SendAndClose(m *ImportBeersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *beerServiceImportBeersServer) Recv() (*ImportBeersRequest, error) {
+	m := new(ImportBeersRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _BeerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "BeerService",
 	HandlerType: (*BeerServiceServer)(nil),
@@ -1176,10 +4691,200 @@ var _BeerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBeer",
 			Handler:    _BeerService_DeleteBeer_Handler,
 		},
+		{
+			MethodName: "UndeleteBeer",
+			Handler:    _BeerService_UndeleteBeer_Handler,
+		},
 		{
 			MethodName: "ListBeers",
 			Handler:    _BeerService_ListBeers_Handler,
 		},
+		{
+			MethodName: "SearchBeers",
+			Handler:    _BeerService_SearchBeers_Handler,
+		},
+		{
+			MethodName: "BatchGetBeers",
+			Handler:    _BeerService_BatchGetBeers_Handler,
+		},
+		{
+			MethodName: "BatchCreateBeers",
+			Handler:    _BeerService_BatchCreateBeers_Handler,
+		},
+		{
+			MethodName: "BatchDeleteBeers",
+			Handler:    _BeerService_BatchDeleteBeers_Handler,
+		},
+		{
+			MethodName: "ListBeerHistory",
+			Handler:    _BeerService_ListBeerHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBeers",
+			Handler:       _BeerService_WatchBeers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportBeers",
+			Handler:       _BeerService_ExportBeers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBeers",
+			Handler:       _BeerService_ImportBeers_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
+
+// APIKeyServiceClient is the client API for APIKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIKeyServiceClient interface {
+	// CreateAPIKey creates an API key.
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists all API keys.
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey revokes an API key given its ID.
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type aPIKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIKeyServiceClient(cc grpc.ClientConnInterface) APIKeyServiceClient {
+	return &aPIKeyServiceClient{cc}
+}
+
+func (c *aPIKeyServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/APIKeyService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/APIKeyService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/APIKeyService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIKeyServiceServer is the server API for APIKeyService service.
+type APIKeyServiceServer interface {
+	// CreateAPIKey creates an API key.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists all API keys.
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// RevokeAPIKey revokes an API key given its ID.
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error)
+}
+
+// UnimplementedAPIKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIKeyServiceServer struct {
+}
+
+func (*UnimplementedAPIKeyServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedAPIKeyServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (*UnimplementedAPIKeyServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+
+func RegisterAPIKeyServiceServer(s *grpc.Server, srv APIKeyServiceServer) {
+	s.RegisterService(&_APIKeyService_serviceDesc, srv)
+}
+
+func _APIKeyService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/APIKeyService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/APIKeyService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/APIKeyService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "APIKeyService",
+	HandlerType: (*APIKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _APIKeyService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _APIKeyService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _APIKeyService_RevokeAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",